@@ -4,6 +4,7 @@ go 1.14
 
 require (
 	github.com/jessevdk/go-flags v1.4.0
+	github.com/pkg/errors v0.8.1
 	go.uber.org/zap v1.16.0
 	gopkg.in/yaml.v2 v2.2.8
 	k8s.io/api v0.17.11
@@ -4,9 +4,11 @@ import (
 	"context"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/jessevdk/go-flags"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 
 	"k8s-cluster-comparator/internal/kubernetes/common"
@@ -24,11 +26,43 @@ var (
 	opts struct {
 		KubeConfig1 string   `long:"kube-config1" env:"KUBECONFIG1" required:"true" description:"Path to Kubernetes client1 config file"`
 		KubeConfig2 string   `long:"kube-config2" env:"KUBECONFIG2" required:"true" description:"Path to Kubernetes client2 config file"`
-		NameSpaces  []string `long:"ns" env:"NAMESPACES" required:"true" description:"Configmaps massive"`
+		NameSpaces  []string `long:"ns" env:"NAMESPACES" description:"Configmaps massive"`
 		Skip        string   `long:"skip" env:"SKIP" required:"false" description:"Skipping an entity"`
+
+		AllNamespaces           bool     `long:"all-namespaces" env:"ALL_NAMESPACES" description:"Compare the union of namespaces of both clusters instead of the --ns list"`
+		NamespacesInclude       []string `long:"ns-include" env:"NAMESPACES_INCLUDE" env-delim:"," description:"Globs or /regular expressions/ of namespace names compared in the all-namespaces mode (all namespaces if empty)"`
+		NamespacesExclude       []string `long:"ns-exclude" env:"NAMESPACES_EXCLUDE" env-delim:"," description:"Globs or /regular expressions/ of namespace names skipped in the all-namespaces mode (e.g. kube-*)"`
+		NamespacesIncludeLabels string   `long:"ns-include-labels" env:"NAMESPACES_INCLUDE_LABELS" description:"Label selector of namespaces compared in the all-namespaces mode (e.g. team=payments)"`
+		NamespacesExcludeLabels string   `long:"ns-exclude-labels" env:"NAMESPACES_EXCLUDE_LABELS" description:"Label selector of namespaces skipped in the all-namespaces mode"`
+
+		NamespaceLabels string `long:"ns-selector" env:"NAMESPACE_SELECTOR" description:"Label selector of namespaces which are reported when they exist in one cluster only (e.g. team=payments)"`
+
+		RevealSecrets bool `long:"reveal-secrets" env:"REVEAL_SECRETS" description:"Print values of differing secret keys (for local debugging only)"`
+
+		SecretTypesInclude []string `long:"secret-types-include" env:"SECRET_TYPES_INCLUDE" env-delim:"," description:"Secret types to compare (all types if empty)"`
+		SecretTypesExclude []string `long:"secret-types-exclude" env:"SECRET_TYPES_EXCLUDE" env-delim:"," default:"kubernetes.io/service-account-token" default:"helm.sh/release.v1" description:"Secret types to skip from comparison"`
+		SecretSkipLabels   string   `long:"secret-skip-labels" env:"SECRET_SKIP_LABELS" description:"Label selector of secrets to skip from comparison (e.g. owner=helm)"`
+
+		TLSExpiryThreshold time.Duration `long:"tls-expiry-threshold" env:"TLS_EXPIRY_THRESHOLD" default:"720h" description:"Warn about TLS certificates expiring within this period (0 disables the check)"`
+
+		IgnoreRegistryTokens bool `long:"ignore-registry-tokens" env:"IGNORE_REGISTRY_TOKENS" description:"Treat registry tokens in docker config secrets as expected to differ, compare registries and usernames only"`
+
+		CompareAllocatedValues bool `long:"compare-allocated-values" env:"COMPARE_ALLOCATED_VALUES" description:"Compare cluster-allocated values of services (ClusterIP, NodePort, HealthCheckNodePort)"`
+		CompareEndpoints       bool `long:"compare-endpoints" env:"COMPARE_ENDPOINTS" description:"Compare ready endpoints and their ports of services existing in both clusters"`
+
+		SemanticCronSchedules bool   `long:"semantic-cron-schedules" env:"SEMANTIC_CRON_SCHEDULES" description:"Treat differently written but equivalent cronJob schedules (e.g. '@daily' and '0 0 * * *') as equal"`
+		CompareJobOutcome     bool   `long:"compare-job-outcome" env:"COMPARE_JOB_OUTCOME" description:"Compare whether jobs existing in both clusters have succeeded, failed or not finished yet"`
+		CompareClusterRBAC    bool   `long:"compare-cluster-rbac" env:"COMPARE_CLUSTER_RBAC" description:"Compare cluster roles and cluster role bindings except the default ones"`
+		ComparePermissions    bool   `long:"compare-permissions" env:"COMPARE_PERMISSIONS" description:"Compare permissions effectively granted to service accounts (requires reading RBAC objects of all namespaces)"`
+		ShowQuotaUsage        bool   `long:"show-quota-usage" env:"SHOW_QUOTA_USAGE" description:"Show current usage of resource quotas of both clusters side by side"`
+		CompareClusterObjects bool   `long:"compare-cluster-objects" env:"COMPARE_CLUSTER_OBJECTS" description:"Compare custom resource definitions, ingress classes, priority classes, runtime classes and webhook configurations"`
+		CompareNodes          bool   `long:"compare-nodes" env:"COMPARE_NODES" description:"Compare node fleets grouped by node pools: instance types, zones, taints, allocatable resources, kubelet, container runtime and OS versions"`
+		JobMatchStrategy      string `long:"job-match" env:"JOB_MATCH" default:"name" choice:"name" choice:"labels" description:"How jobs of both clusters are paired: by name with the generateName suffix stripped or by label set. Runs of cronJobs are always paired by their owner"`
 	}
 
 	ErrHelpShown = errors.New("help message shown")
+
+	ErrNoNamespaces = errors.New("namespaces are not set, use --ns or --all-namespaces")
 )
 
 // ClusterConfig represents a k8s-cluster config
@@ -45,8 +79,57 @@ type AppConfig struct {
 	Namespaces []string
 
 	SkipEntitiesList skipper.SkipEntitiesList
+
+	// RevealSecrets allows printing secret values in difference reports
+	RevealSecrets bool
+
+	// SecretTypesInclude is a list of secret types to compare, all types are compared if it is empty
+	SecretTypesInclude []string
+	// SecretTypesExclude is a list of secret types to skip from comparison
+	SecretTypesExclude []string
+	// SecretSkipSelector matches secrets to skip from comparison by their labels
+	SecretSkipSelector labels.Selector
+	// TLSExpiryThreshold is a period within which expiring TLS certificates are reported
+	TLSExpiryThreshold time.Duration
+	// IgnoreRegistryTokens makes docker config secrets comparison ignore token values
+	IgnoreRegistryTokens bool
+
+	// CompareAllocatedValues enables comparison of values allocated by a cluster, such as service ClusterIP and NodePort
+	CompareAllocatedValues bool
+	// CompareEndpoints enables comparison of endpoints backing services
+	CompareEndpoints bool
+
+	// SemanticCronSchedules makes cronJobs comparison treat equivalent cron expressions as equal
+	SemanticCronSchedules bool
+	// CompareJobOutcome enables comparison of job outcomes (succeeded, failed or not finished)
+	CompareJobOutcome bool
+	// JobMatchStrategy defines how jobs of both clusters are paired (by "name" or by "labels")
+	JobMatchStrategy string
+
+	// CompareClusterRBAC enables comparison of cluster roles and cluster role bindings
+	CompareClusterRBAC bool
+	// ComparePermissions enables comparison of permissions effectively granted to service accounts
+	ComparePermissions bool
+
+	// ShowQuotaUsage enables printing of current resource quota usage of both clusters
+	ShowQuotaUsage bool
+
+	// CompareClusterObjects enables comparison of cluster-scoped extension, ingress, scheduling and admission objects
+	CompareClusterObjects bool
+	// CompareNodes enables comparison of node fleets grouped by node pools
+	CompareNodes bool
+
+	// NamespaceSelector matches namespaces which are reported when they exist in one cluster only
+	NamespaceSelector labels.Selector
+
+	// AllNamespaces enables comparison of the union of namespaces of both clusters selected by NamespaceFilter
+	AllNamespaces bool
+	// NamespaceFilter selects namespaces compared in the all-namespaces mode
+	NamespaceFilter NamespaceFilter
 }
 
+type configCtxKey struct{}
+
 // Parse performs configuration parsing from various sources and fills in the AppConfig struct
 func Parse(ctx context.Context) (*AppConfig, error) {
 	log := logging.FromContext(ctx)
@@ -73,12 +156,64 @@ func Parse(ctx context.Context) (*AppConfig, error) {
 		},
 	}
 
-	if strings.Contains(opts.NameSpaces[0], ",") {
+	switch {
+	case opts.AllNamespaces:
+		if len(opts.NameSpaces) > 0 {
+			log.Warn("the list of namespaces is ignored in the all-namespaces mode")
+		}
+
+		appConfig.AllNamespaces = true
+
+		appConfig.NamespaceFilter, err = parseNamespaceFilter()
+		if err != nil {
+			log.Errorf("cannot parse namespace filter: %s", err.Error())
+			return nil, err
+		}
+	case len(opts.NameSpaces) == 0:
+		log.Error(ErrNoNamespaces.Error())
+		return nil, ErrNoNamespaces
+	case strings.Contains(opts.NameSpaces[0], ","):
 		appConfig.Namespaces = strings.Split(opts.NameSpaces[0], NamespacesListSep)
-	} else {
+	default:
 		appConfig.Namespaces = opts.NameSpaces
 	}
 
+	appConfig.RevealSecrets = opts.RevealSecrets
+	if appConfig.RevealSecrets {
+		log.Warn("secret values will be revealed in difference reports")
+	}
+
+	appConfig.SecretTypesInclude = opts.SecretTypesInclude
+	appConfig.SecretTypesExclude = opts.SecretTypesExclude
+	appConfig.TLSExpiryThreshold = opts.TLSExpiryThreshold
+	appConfig.IgnoreRegistryTokens = opts.IgnoreRegistryTokens
+	appConfig.CompareAllocatedValues = opts.CompareAllocatedValues
+	appConfig.CompareEndpoints = opts.CompareEndpoints
+	appConfig.SemanticCronSchedules = opts.SemanticCronSchedules
+	appConfig.CompareJobOutcome = opts.CompareJobOutcome
+	appConfig.JobMatchStrategy = opts.JobMatchStrategy
+	appConfig.CompareClusterRBAC = opts.CompareClusterRBAC
+	appConfig.ComparePermissions = opts.ComparePermissions
+	appConfig.ShowQuotaUsage = opts.ShowQuotaUsage
+	appConfig.CompareClusterObjects = opts.CompareClusterObjects
+	appConfig.CompareNodes = opts.CompareNodes
+
+	if opts.SecretSkipLabels != "" {
+		appConfig.SecretSkipSelector, err = labels.Parse(opts.SecretSkipLabels)
+		if err != nil {
+			log.Errorf("cannot parse secret skip label selector: %s", err.Error())
+			return nil, err
+		}
+	}
+
+	if opts.NamespaceLabels != "" {
+		appConfig.NamespaceSelector, err = labels.Parse(opts.NamespaceLabels)
+		if err != nil {
+			log.Errorf("cannot parse namespace label selector: %s", err.Error())
+			return nil, err
+		}
+	}
+
 	if opts.Skip != "" {
 		log.Debug("Filling the skip list...")
 
@@ -92,3 +227,50 @@ func Parse(ctx context.Context) (*AppConfig, error) {
 
 	return appConfig, nil
 }
+
+// parseNamespaceFilter parses patterns and label selectors of namespaces compared in the all-namespaces mode
+func parseNamespaceFilter() (NamespaceFilter, error) {
+	var (
+		filter NamespaceFilter
+		err    error
+	)
+
+	filter.Include, err = parseNamespacePatterns(opts.NamespacesInclude)
+	if err != nil {
+		return filter, err
+	}
+
+	filter.Exclude, err = parseNamespacePatterns(opts.NamespacesExclude)
+	if err != nil {
+		return filter, err
+	}
+
+	if opts.NamespacesIncludeLabels != "" {
+		filter.IncludeSelector, err = labels.Parse(opts.NamespacesIncludeLabels)
+		if err != nil {
+			return filter, err
+		}
+	}
+
+	if opts.NamespacesExcludeLabels != "" {
+		filter.ExcludeSelector, err = labels.Parse(opts.NamespacesExcludeLabels)
+		if err != nil {
+			return filter, err
+		}
+	}
+
+	return filter, nil
+}
+
+// WithConfig returns a copy of ctx that carries the application configuration
+func WithConfig(ctx context.Context, cfg *AppConfig) context.Context {
+	return context.WithValue(ctx, configCtxKey{}, cfg)
+}
+
+// FromContext returns the application configuration stored in ctx or an empty configuration if there is none
+func FromContext(ctx context.Context) *AppConfig {
+	if cfg, ok := ctx.Value(configCtxKey{}).(*AppConfig); ok && cfg != nil {
+		return cfg
+	}
+	return &AppConfig{}
+}
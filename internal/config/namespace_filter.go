@@ -0,0 +1,112 @@
+package config
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// NamespacePattern matches namespace names either by a glob (e.g. "kube-*") or by a regular expression
+// written between slashes (e.g. "/^team-(a|b)$/")
+type NamespacePattern struct {
+	glob  string
+	regex *regexp.Regexp
+}
+
+// NamespaceFilter selects namespaces compared in the all-namespaces mode
+type NamespaceFilter struct {
+	// Include patterns, a namespace matching none of them is skipped unless the list is empty
+	Include []NamespacePattern
+	// Exclude patterns, a namespace matching any of them is skipped
+	Exclude []NamespacePattern
+
+	// IncludeSelector matches labels of compared namespaces, nil matches all namespaces
+	IncludeSelector labels.Selector
+	// ExcludeSelector matches labels of skipped namespaces, nil matches no namespaces
+	ExcludeSelector labels.Selector
+}
+
+// ParseNamespacePattern parses a glob or a regular expression written between slashes
+func ParseNamespacePattern(pattern string) (NamespacePattern, error) {
+	pattern = strings.TrimSpace(pattern)
+
+	if len(pattern) > 1 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+		regex, err := regexp.Compile(pattern[1 : len(pattern)-1])
+		if err != nil {
+			return NamespacePattern{}, fmt.Errorf("invalid namespace regular expression '%s': %w", pattern, err)
+		}
+
+		return NamespacePattern{regex: regex}, nil
+	}
+
+	if _, err := path.Match(pattern, ""); err != nil {
+		return NamespacePattern{}, fmt.Errorf("invalid namespace glob '%s': %w", pattern, err)
+	}
+
+	return NamespacePattern{glob: pattern}, nil
+}
+
+// Match checks whether the namespace name matches the pattern
+func (p NamespacePattern) Match(name string) bool {
+	if p.regex != nil {
+		return p.regex.MatchString(name)
+	}
+
+	isMatched, _ := path.Match(p.glob, name)
+
+	return isMatched
+}
+
+// IsNamespaceSelected checks whether the namespace with the given name and labels passes the filter
+func (f *NamespaceFilter) IsNamespaceSelected(name string, namespaceLabels map[string]string) bool {
+	if len(f.Include) > 0 && !matchesAnyPattern(f.Include, name) {
+		return false
+	}
+
+	if matchesAnyPattern(f.Exclude, name) {
+		return false
+	}
+
+	if f.IncludeSelector != nil && !f.IncludeSelector.Matches(labels.Set(namespaceLabels)) {
+		return false
+	}
+
+	if f.ExcludeSelector != nil && f.ExcludeSelector.Matches(labels.Set(namespaceLabels)) {
+		return false
+	}
+
+	return true
+}
+
+// parseNamespacePatterns parses a list of patterns
+func parseNamespacePatterns(patterns []string) ([]NamespacePattern, error) {
+	parsed := make([]NamespacePattern, 0, len(patterns))
+
+	for _, pattern := range patterns {
+		if strings.TrimSpace(pattern) == "" {
+			continue
+		}
+
+		namespacePattern, err := ParseNamespacePattern(pattern)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, namespacePattern)
+	}
+
+	return parsed, nil
+}
+
+// matchesAnyPattern checks whether the name matches any of the patterns
+func matchesAnyPattern(patterns []NamespacePattern, name string) bool {
+	for _, pattern := range patterns {
+		if pattern.Match(name) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,11 @@
+package autoscaling
+
+import "errors"
+
+var (
+	ErrorScaleTargetDifferent     = errors.New("the scale target in the horizontal pod autoscalers is different")
+	ErrorMinReplicasDifferent     = errors.New("the minimum replicas in the horizontal pod autoscalers are different")
+	ErrorMaxReplicasDifferent     = errors.New("the maximum replicas in the horizontal pod autoscalers are different")
+	ErrorMetricsDifferent         = errors.New("the metrics in the horizontal pod autoscalers are different")
+	ErrorScalingBehaviorDifferent = errors.New("the scaling behavior in the horizontal pod autoscalers is different")
+)
@@ -0,0 +1,197 @@
+package autoscaling
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+	"k8s-cluster-comparator/internal/kubernetes/kv_maps"
+	"k8s-cluster-comparator/internal/kubernetes/skipper"
+	"k8s-cluster-comparator/internal/kubernetes/types"
+)
+
+// ReplicaBounds describes replicas of a pod controller managed by a horizontal pod autoscaler
+type ReplicaBounds struct {
+	AutoscalerName string
+
+	MinReplicas int32
+	MaxReplicas int32
+}
+
+// Autoscalers holds horizontal pod autoscalers of a namespace obtained once and shared by comparisons of autoscalers and pod controllers
+type Autoscalers struct {
+	items []hpa
+}
+
+// GetAutoscalers obtains horizontal pod autoscalers of the namespace. A cluster which does not serve autoscalers or
+// forbids listing them is treated as having none of them, so replicas of pod controllers are compared as is
+func GetAutoscalers(clientSet kubernetes.Interface, namespace string) (*Autoscalers, error) {
+	items, err := getHorizontalPodAutoscalers(clientSet, namespace)
+	if errors.Is(err, common.ErrResourceNotServed) || common.IsForbidden(err) {
+		log.Warnf("horizontal pod autoscalers of namespace '%s' are not compared: %s", namespace, err.Error())
+		return &Autoscalers{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &Autoscalers{items: items}, nil
+}
+
+// ScaleTargets returns replica bounds of pod controllers managed by the autoscalers, keyed by
+// the kind and the name of the controller, e.g. "Deployment/api"
+func (a *Autoscalers) ScaleTargets() map[string]ReplicaBounds {
+	targets := make(map[string]ReplicaBounds, len(a.items))
+
+	for _, autoscaler := range a.items {
+		targets[autoscaler.Spec.ScaleTargetRef.Kind+"/"+autoscaler.Spec.ScaleTargetRef.Name] = ReplicaBounds{
+			AutoscalerName: autoscaler.Name,
+			MinReplicas:    getMinReplicas(autoscaler.Spec),
+			MaxReplicas:    autoscaler.Spec.MaxReplicas,
+		}
+	}
+
+	return targets
+}
+
+// CompareHorizontalPodAutoscalers compares horizontal pod autoscalers of the namespace obtained with GetAutoscalers from two given k8s-clusters
+func CompareHorizontalPodAutoscalers(autoscalers1, autoscalers2 *Autoscalers, skipEntityList skipper.SkipEntitiesList) bool {
+	mapAutoscalers1, mapAutoscalers2 := prepareHPAMaps(autoscalers1.items, autoscalers2.items, skipEntityList.GetByKind("horizontalpodautoscalers"))
+
+	return setInformationAboutHPAs(mapAutoscalers1, mapAutoscalers2, autoscalers1.items, autoscalers2.items)
+}
+
+// prepareHPAMaps add value horizontal pod autoscalers in map
+func prepareHPAMaps(autoscalers1, autoscalers2 []hpa, skipEntities skipper.SkipComponentNames) (map[string]types.IsAlreadyComparedFlag, map[string]types.IsAlreadyComparedFlag) { //nolint:gocritic,unused
+	mapAutoscalers1 := make(map[string]types.IsAlreadyComparedFlag)
+	mapAutoscalers2 := make(map[string]types.IsAlreadyComparedFlag)
+	var indexCheck types.IsAlreadyComparedFlag
+
+	for index, value := range autoscalers1 {
+		if skipEntities.IsSkippedEntity(value.Name) {
+			log.Debugf("horizontal pod autoscaler %s is skipped from comparison due to its name", value.Name)
+			continue
+		}
+		indexCheck.Index = index
+		mapAutoscalers1[value.Name] = indexCheck
+	}
+	for index, value := range autoscalers2 {
+		if skipEntities.IsSkippedEntity(value.Name) {
+			log.Debugf("horizontal pod autoscaler %s is skipped from comparison due to its name", value.Name)
+			continue
+		}
+		indexCheck.Index = index
+		mapAutoscalers2[value.Name] = indexCheck
+	}
+
+	return mapAutoscalers1, mapAutoscalers2
+}
+
+// setInformationAboutHPAs set information about horizontal pod autoscalers
+func setInformationAboutHPAs(map1, map2 map[string]types.IsAlreadyComparedFlag, autoscalers1, autoscalers2 []hpa) bool {
+	var (
+		flag bool
+	)
+
+	if len(map1) != len(map2) {
+		log.Infof("horizontal pod autoscaler counts are different")
+		flag = true
+	}
+
+	wg := &sync.WaitGroup{}
+	channel := make(chan bool, len(map1))
+
+	for name, index1 := range map1 {
+		if index2, ok := map2[name]; ok {
+			wg.Add(1)
+
+			index1.Check = true
+			map1[name] = index1
+			index2.Check = true
+			map2[name] = index2
+
+			go compareHPASpecInternals(wg, channel, name, &autoscalers1[index1.Index], &autoscalers2[index2.Index])
+		} else {
+			log.Infof("horizontal pod autoscaler '%s' does not exist in 2nd cluster", name)
+			flag = true
+			channel <- flag
+		}
+	}
+
+	wg.Wait()
+
+	close(channel)
+
+	for ch := range channel {
+		if ch {
+			flag = true
+		}
+	}
+	for name, index := range map2 {
+		if !index.Check {
+			log.Infof("horizontal pod autoscaler '%s' does not exist in 1st cluster", name)
+			flag = true
+		}
+	}
+
+	return flag
+}
+
+func compareHPASpecInternals(wg *sync.WaitGroup, channel chan bool, name string, autoscaler1, autoscaler2 *hpa) {
+	var (
+		flag bool
+	)
+	defer func() {
+		wg.Done()
+	}()
+
+	log.Debugf("----- Start checking horizontal pod autoscaler: '%s' -----", name)
+
+	if !kv_maps.AreKVMapsEqual(autoscaler1.Labels, autoscaler2.Labels, common.SkippedKubeLabels) {
+		log.Infof("metadata of horizontal pod autoscaler '%s' differs: different labels", autoscaler1.Name)
+		channel <- true
+		return
+	}
+
+	err := compareSpecInHPAs(autoscaler1.Spec, autoscaler2.Spec)
+	if err != nil {
+		log.Infof("HorizontalPodAutoscaler %s: %s", name, err.Error())
+		flag = true
+	}
+
+	log.Debugf("----- End checking horizontal pod autoscaler: '%s' -----", name)
+	channel <- flag
+}
+
+// compareSpecInHPAs compares the scale target, replica bounds, metric targets and scaling behavior of autoscalers
+func compareSpecInHPAs(spec1, spec2 hpaSpec) error {
+	target1 := spec1.ScaleTargetRef.Kind + "/" + spec1.ScaleTargetRef.Name
+	target2 := spec2.ScaleTargetRef.Kind + "/" + spec2.ScaleTargetRef.Name
+	if target1 != target2 {
+		return fmt.Errorf("%w. First autoscaler: '%s'. Second autoscaler: '%s'", ErrorScaleTargetDifferent, target1, target2)
+	}
+
+	if getMinReplicas(spec1) != getMinReplicas(spec2) {
+		return fmt.Errorf("%w. First autoscaler: %d. Second autoscaler: %d", ErrorMinReplicasDifferent, getMinReplicas(spec1), getMinReplicas(spec2))
+	}
+
+	if spec1.MaxReplicas != spec2.MaxReplicas {
+		return fmt.Errorf("%w. First autoscaler: %d. Second autoscaler: %d", ErrorMaxReplicasDifferent, spec1.MaxReplicas, spec2.MaxReplicas)
+	}
+
+	metrics1, metrics2 := strings.Join(formatMetrics(spec1), "; "), strings.Join(formatMetrics(spec2), "; ")
+	if metrics1 != metrics2 {
+		return fmt.Errorf("%w. First autoscaler: [%s]. Second autoscaler: [%s]", ErrorMetricsDifferent, metrics1, metrics2)
+	}
+
+	behavior1, behavior2 := formatBehavior(spec1.Behavior), formatBehavior(spec2.Behavior)
+	if behavior1 != behavior2 {
+		return fmt.Errorf("%w. First autoscaler: '%s'. Second autoscaler: '%s'", ErrorScalingBehaviorDifferent, behavior1, behavior2)
+	}
+
+	return nil
+}
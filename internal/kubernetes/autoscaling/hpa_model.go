@@ -0,0 +1,250 @@
+package autoscaling
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/api/autoscaling/v2beta2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+)
+
+const (
+	hpaGroupVersionV2      = "autoscaling/v2"
+	hpaGroupVersionV2beta2 = "autoscaling/v2beta2"
+	hpaGroupVersionV1      = "autoscaling/v1"
+
+	// defaultCPUUtilization is the target used by the autoscaler when no metrics are specified
+	defaultCPUUtilization = 80
+)
+
+var (
+	// hpaGroupVersions lists group versions serving autoscalers from the most to the least preferred one
+	hpaGroupVersions = []string{
+		hpaGroupVersionV2,
+		hpaGroupVersionV2beta2,
+		hpaGroupVersionV1,
+	}
+
+	// defaultScaleUpRules and defaultScaleDownRules are applied by the API server when the behavior is not specified
+	defaultScaleUpRules = hpaScalingRules{
+		StabilizationWindowSeconds: int32Ptr(0),
+		SelectPolicy:               stringPtr("Max"),
+		Policies: []hpaScalingPolicy{
+			{Type: "Pods", Value: 4, PeriodSeconds: 15},
+			{Type: "Percent", Value: 100, PeriodSeconds: 15},
+		},
+	}
+	defaultScaleDownRules = hpaScalingRules{
+		StabilizationWindowSeconds: int32Ptr(300),
+		SelectPolicy:               stringPtr("Max"),
+		Policies: []hpaScalingPolicy{
+			{Type: "Percent", Value: 100, PeriodSeconds: 15},
+		},
+	}
+)
+
+// hpaList mirrors HorizontalPodAutoscalerList of autoscaling/v1, autoscaling/v2beta2 and autoscaling/v2.
+// autoscaling/v2 and the behavior field are unknown to the vendored client-go
+type hpaList struct {
+	Items []hpa `json:"items"`
+}
+
+// hpa mirrors a HorizontalPodAutoscaler of any served version
+type hpa struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec hpaSpec `json:"spec,omitempty"`
+}
+
+// hpaSpec mirrors an autoscaler spec of autoscaling/v2beta2 extended with fields of autoscaling/v1 and autoscaling/v2
+type hpaSpec struct {
+	v2beta2.HorizontalPodAutoscalerSpec
+
+	// Metrics shadows the embedded metrics to keep metric sources unknown to the vendored client-go
+	Metrics []hpaMetricSpec `json:"metrics,omitempty"`
+
+	Behavior *hpaBehavior `json:"behavior,omitempty"`
+
+	// TargetCPUUtilizationPercentage is set by autoscaling/v1 only
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+}
+
+// hpaMetricSpec mirrors a metric spec extended with the container resource source added in autoscaling/v2
+type hpaMetricSpec struct {
+	v2beta2.MetricSpec
+
+	ContainerResource *hpaContainerResourceMetricSource `json:"containerResource,omitempty"`
+}
+
+// hpaContainerResourceMetricSource mirrors a container resource metric source
+type hpaContainerResourceMetricSource struct {
+	Name      string               `json:"name"`
+	Container string               `json:"container"`
+	Target    v2beta2.MetricTarget `json:"target"`
+}
+
+// hpaBehavior mirrors the scaling behavior of an autoscaler
+type hpaBehavior struct {
+	ScaleUp   *hpaScalingRules `json:"scaleUp,omitempty"`
+	ScaleDown *hpaScalingRules `json:"scaleDown,omitempty"`
+}
+
+// hpaScalingRules mirrors scaling rules of one direction
+type hpaScalingRules struct {
+	StabilizationWindowSeconds *int32             `json:"stabilizationWindowSeconds,omitempty"`
+	SelectPolicy               *string            `json:"selectPolicy,omitempty"`
+	Policies                   []hpaScalingPolicy `json:"policies,omitempty"`
+}
+
+// hpaScalingPolicy mirrors a single scaling policy
+type hpaScalingPolicy struct {
+	Type          string `json:"type"`
+	Value         int32  `json:"value"`
+	PeriodSeconds int32  `json:"periodSeconds"`
+}
+
+// getHorizontalPodAutoscalers returns autoscalers of the namespace using the most preferred API version served by the cluster
+func getHorizontalPodAutoscalers(clientSet kubernetes.Interface, namespace string) ([]hpa, error) {
+	groupVersion, err := common.GetServedGroupVersion(clientSet, "horizontalpodautoscalers", hpaGroupVersions...)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("horizontal pod autoscalers are obtained using '%s' API", groupVersion)
+
+	list := hpaList{}
+
+	if err := common.GetRawResourceList(clientSet, groupVersion, namespace, "horizontalpodautoscalers", &list); err != nil {
+		return nil, fmt.Errorf("cannot obtain horizontal pod autoscalers using '%s' API: %w", groupVersion, err)
+	}
+
+	return list.Items, nil
+}
+
+// getMinReplicas returns the minimum replicas of the autoscaler, the API server defaults it to 1
+func getMinReplicas(spec hpaSpec) int32 {
+	if spec.MinReplicas == nil {
+		return 1
+	}
+
+	return *spec.MinReplicas
+}
+
+// formatMetrics returns sorted human-readable metric targets of the autoscaler. The CPU target of autoscaling/v1
+// is converted to a resource metric, an autoscaler without metrics targets the default CPU utilization
+func formatMetrics(spec hpaSpec) []string {
+	metrics := make([]string, 0, len(spec.Metrics)+1)
+
+	if spec.TargetCPUUtilizationPercentage != nil {
+		metrics = append(metrics, fmt.Sprintf("resource cpu: average utilization %d%%", *spec.TargetCPUUtilizationPercentage))
+	}
+
+	for _, metric := range spec.Metrics {
+		metrics = append(metrics, formatMetric(metric))
+	}
+
+	if len(metrics) == 0 {
+		metrics = append(metrics, fmt.Sprintf("resource cpu: average utilization %d%%", defaultCPUUtilization))
+	}
+
+	sort.Strings(metrics)
+
+	return metrics
+}
+
+// formatMetric returns a human-readable representation of the metric source and its target
+func formatMetric(metric hpaMetricSpec) string {
+	switch {
+	case metric.Resource != nil:
+		return fmt.Sprintf("resource %s: %s", metric.Resource.Name, formatMetricTarget(metric.Resource.Target))
+	case metric.ContainerResource != nil:
+		return fmt.Sprintf("resource %s of container %s: %s", metric.ContainerResource.Name, metric.ContainerResource.Container, formatMetricTarget(metric.ContainerResource.Target))
+	case metric.Pods != nil:
+		return fmt.Sprintf("pods metric %s: %s", formatMetricIdentifier(metric.Pods.Metric), formatMetricTarget(metric.Pods.Target))
+	case metric.Object != nil:
+		return fmt.Sprintf("object metric %s of %s/%s: %s", formatMetricIdentifier(metric.Object.Metric), metric.Object.DescribedObject.Kind, metric.Object.DescribedObject.Name, formatMetricTarget(metric.Object.Target))
+	case metric.External != nil:
+		return fmt.Sprintf("external metric %s: %s", formatMetricIdentifier(metric.External.Metric), formatMetricTarget(metric.External.Target))
+	}
+
+	return fmt.Sprintf("%s metric", metric.Type)
+}
+
+// formatMetricIdentifier returns the metric name with its selector
+func formatMetricIdentifier(identifier v2beta2.MetricIdentifier) string {
+	if identifier.Selector == nil {
+		return identifier.Name
+	}
+
+	return fmt.Sprintf("%s{%s}", identifier.Name, metav1.FormatLabelSelector(identifier.Selector))
+}
+
+// formatMetricTarget returns the target value in the canonical form, e.g. 1000m and 1 are equal
+func formatMetricTarget(target v2beta2.MetricTarget) string {
+	switch {
+	case target.AverageUtilization != nil:
+		return fmt.Sprintf("average utilization %d%%", *target.AverageUtilization)
+	case target.AverageValue != nil:
+		return fmt.Sprintf("average value %s", target.AverageValue.String())
+	case target.Value != nil:
+		return fmt.Sprintf("value %s", target.Value.String())
+	}
+
+	return string(target.Type)
+}
+
+// formatBehavior returns the scaling behavior with the defaults of the API server applied
+func formatBehavior(behavior *hpaBehavior) string {
+	scaleUp, scaleDown := defaultScaleUpRules, defaultScaleDownRules
+
+	if behavior != nil {
+		scaleUp = withDefaultRules(behavior.ScaleUp, defaultScaleUpRules)
+		scaleDown = withDefaultRules(behavior.ScaleDown, defaultScaleDownRules)
+	}
+
+	return fmt.Sprintf("scale up: %s; scale down: %s", formatScalingRules(scaleUp), formatScalingRules(scaleDown))
+}
+
+// withDefaultRules fills unset fields of scaling rules with the defaults
+func withDefaultRules(rules *hpaScalingRules, defaults hpaScalingRules) hpaScalingRules {
+	if rules == nil {
+		return defaults
+	}
+
+	result := *rules
+
+	if result.StabilizationWindowSeconds == nil {
+		result.StabilizationWindowSeconds = defaults.StabilizationWindowSeconds
+	}
+	if result.SelectPolicy == nil {
+		result.SelectPolicy = defaults.SelectPolicy
+	}
+	if len(result.Policies) == 0 {
+		result.Policies = defaults.Policies
+	}
+
+	return result
+}
+
+// formatScalingRules returns scaling rules with policies regardless of their order
+func formatScalingRules(rules hpaScalingRules) string {
+	policies := make([]string, 0, len(rules.Policies))
+	for _, policy := range rules.Policies {
+		policies = append(policies, fmt.Sprintf("%s %d per %ds", policy.Type, policy.Value, policy.PeriodSeconds))
+	}
+	sort.Strings(policies)
+
+	return fmt.Sprintf("stabilization window %ds, select policy %s, policies [%s]", *rules.StabilizationWindowSeconds, *rules.SelectPolicy, strings.Join(policies, ", "))
+}
+
+func int32Ptr(value int32) *int32 {
+	return &value
+}
+
+func stringPtr(value string) *string {
+	return &value
+}
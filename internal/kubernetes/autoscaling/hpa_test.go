@@ -0,0 +1,104 @@
+package autoscaling
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+	"k8s-cluster-comparator/internal/logging"
+)
+
+func decodeHPASpec(t *testing.T, data string) hpaSpec {
+	autoscaler := hpa{}
+	if err := json.Unmarshal([]byte(data), &autoscaler); err != nil {
+		t.Fatalf("cannot decode autoscaler: %s", err.Error())
+	}
+
+	return autoscaler.Spec
+}
+
+func TestCompareSpecInHPAs(t *testing.T) {
+	if err := logging.Configure(false); err != nil {
+		t.Fatalf("cannot configure logging: %s", err.Error())
+	}
+	if err := Init(context.Background()); err != nil {
+		t.Fatalf("cannot init autoscaling package: %s", err.Error())
+	}
+
+	// autoscaling/v1 object with the CPU target and default behavior
+	specV1 := decodeHPASpec(t, `{"spec": {
+		"scaleTargetRef": {"apiVersion": "apps/v1", "kind": "Deployment", "name": "api"},
+		"minReplicas": 2, "maxReplicas": 10, "targetCPUUtilizationPercentage": 70}}`)
+
+	// autoscaling/v2 object with the same target written as a resource metric and explicit default behavior
+	specV2 := decodeHPASpec(t, `{"spec": {
+		"scaleTargetRef": {"apiVersion": "apps/v1", "kind": "Deployment", "name": "api"},
+		"minReplicas": 2, "maxReplicas": 10,
+		"metrics": [{"type": "Resource", "resource": {"name": "cpu", "target": {"type": "Utilization", "averageUtilization": 70}}}],
+		"behavior": {"scaleDown": {"stabilizationWindowSeconds": 300, "policies": [{"type": "Percent", "value": 100, "periodSeconds": 15}]}}}}`)
+
+	if err := compareSpecInHPAs(specV1, specV2); err != nil {
+		t.Errorf("Equivalent autoscalers are reported as different: %s", err.Error())
+	}
+
+	specV2.MaxReplicas = 20
+	if err := compareSpecInHPAs(specV1, specV2); !errors.Is(err, ErrorMaxReplicasDifferent) {
+		t.Errorf("Different maximum replicas are not reported: %v", err)
+	}
+
+	specV1.MaxReplicas = 20
+	specV1.Behavior = &hpaBehavior{ScaleDown: &hpaScalingRules{StabilizationWindowSeconds: int32Ptr(60)}}
+	if err := compareSpecInHPAs(specV1, specV2); !errors.Is(err, ErrorScalingBehaviorDifferent) {
+		t.Errorf("Different scaling behavior is not reported: %v", err)
+	}
+
+	specV1.Behavior = nil
+	specV1.TargetCPUUtilizationPercentage = nil
+	if err := compareSpecInHPAs(specV1, specV2); !errors.Is(err, ErrorMetricsDifferent) {
+		t.Errorf("Different metric targets are not reported: %v", err)
+	}
+}
+
+func TestScaleTargets(t *testing.T) {
+	autoscaler := hpa{}
+	if err := json.Unmarshal([]byte(`{"metadata": {"name": "api-hpa"}, "spec": {
+		"scaleTargetRef": {"apiVersion": "apps/v1", "kind": "Deployment", "name": "api"}, "maxReplicas": 10}}`), &autoscaler); err != nil {
+		t.Fatalf("cannot decode autoscaler: %s", err.Error())
+	}
+
+	targets := (&Autoscalers{items: []hpa{autoscaler}}).ScaleTargets()
+
+	bounds, ok := targets["Deployment/api"]
+	if !ok {
+		t.Fatalf("scale target is not found: %#v", targets)
+	}
+	if bounds.AutoscalerName != "api-hpa" || bounds.MinReplicas != 1 || bounds.MaxReplicas != 10 {
+		t.Errorf("wrong replica bounds of the scale target: %#v", bounds)
+	}
+}
+
+func TestGetAutoscalersNotServed(t *testing.T) {
+	if err := logging.Configure(false); err != nil {
+		t.Fatalf("cannot configure logging: %s", err.Error())
+	}
+	if err := Init(context.Background()); err != nil {
+		t.Fatalf("cannot init autoscaling package: %s", err.Error())
+	}
+
+	clientSet := fake.NewSimpleClientset()
+	if _, err := common.DiscoverAPISurface(clientSet); err != nil {
+		t.Fatalf("cannot discover API surface: %s", err.Error())
+	}
+
+	autoscalers, err := GetAutoscalers(clientSet, "default")
+	if err != nil {
+		t.Fatalf("Cluster not serving autoscalers is reported as an error: %s", err.Error())
+	}
+	if targets := autoscalers.ScaleTargets(); len(targets) != 0 {
+		t.Errorf("Cluster not serving autoscalers has scale targets: %#v", targets)
+	}
+}
@@ -0,0 +1,137 @@
+package cluster
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+	"k8s-cluster-comparator/internal/kubernetes/skipper"
+)
+
+var (
+	// nonDigits is stripped from version parts reported by managed clusters, e.g. "17+" on GKE and EKS
+	nonDigits = regexp.MustCompile(`[^0-9]`)
+)
+
+// CompareAPISurfaces compares server versions and served group versions and resources of two given k8s-clusters.
+// Discovered API surfaces are remembered, so comparers pick versioned clients without querying the API servers again
+func CompareAPISurfaces(clientSet1, clientSet2 kubernetes.Interface, skipEntityList skipper.SkipEntitiesList) (bool, error) {
+	var (
+		flag bool
+	)
+
+	surface1, err := common.DiscoverAPISurface(clientSet1)
+	if err != nil {
+		return false, fmt.Errorf("cannot discover API of 1st cluster: %w", err)
+	}
+
+	surface2, err := common.DiscoverAPISurface(clientSet2)
+	if err != nil {
+		return false, fmt.Errorf("cannot discover API of 2nd cluster: %w", err)
+	}
+
+	logFailedGroupVersions("1st", surface1)
+	logFailedGroupVersions("2nd", surface2)
+
+	if err := compareServerVersions(surface1.ServerVersion, surface2.ServerVersion); err != nil {
+		log.Infof("%s", err.Error())
+		flag = true
+	}
+
+	skipEntities := skipEntityList.GetByKind("apiversions")
+
+	for _, groupVersion := range getGroupVersions(surface1, surface2) {
+		if skipEntities.IsSkippedEntity(groupVersion) {
+			log.Debugf("API %s is skipped from comparison due to its name", groupVersion)
+			continue
+		}
+
+		_, isFailed1 := surface1.FailedGroupVersions[groupVersion]
+		_, isFailed2 := surface2.FailedGroupVersions[groupVersion]
+		if isFailed1 || isFailed2 {
+			continue
+		}
+
+		resources1, ok1 := surface1.Resources[groupVersion]
+		resources2, ok2 := surface2.Resources[groupVersion]
+
+		switch {
+		case !ok2:
+			log.Infof("API '%s' is served in 1st cluster only: %s", groupVersion, strings.Join(common.SubtractStringSets(resources1, nil), ", "))
+			flag = true
+		case !ok1:
+			log.Infof("API '%s' is served in 2nd cluster only: %s", groupVersion, strings.Join(common.SubtractStringSets(resources2, nil), ", "))
+			flag = true
+		default:
+			if onlyIn1 := common.SubtractStringSets(resources1, resources2); len(onlyIn1) > 0 {
+				log.Infof("API '%s' serves resources in 1st cluster only: %s", groupVersion, strings.Join(onlyIn1, ", "))
+				flag = true
+			}
+			if onlyIn2 := common.SubtractStringSets(resources2, resources1); len(onlyIn2) > 0 {
+				log.Infof("API '%s' serves resources in 2nd cluster only: %s", groupVersion, strings.Join(onlyIn2, ", "))
+				flag = true
+			}
+		}
+	}
+
+	return flag, nil
+}
+
+// compareServerVersions compares major and minor server versions, clusters differing in patch versions only are comparable
+func compareServerVersions(version1, version2 *version.Info) error {
+	minor1, minor2 := getMinorVersion(version1), getMinorVersion(version2)
+	if minor1 != minor2 {
+		return fmt.Errorf("%w. First cluster: '%s'. Second cluster: '%s'", ErrorServerVersionsDifferent, version1.GitVersion, version2.GitVersion)
+	}
+
+	if version1.GitVersion != version2.GitVersion {
+		log.Debugf("server versions differ in patch versions only: '%s' and '%s'", version1.GitVersion, version2.GitVersion)
+	}
+
+	return nil
+}
+
+// getMinorVersion returns the major and minor server version, e.g. "1.17" for "17+" reported by a managed cluster
+func getMinorVersion(serverVersion *version.Info) string {
+	return nonDigits.ReplaceAllString(serverVersion.Major, "") + "." + nonDigits.ReplaceAllString(serverVersion.Minor, "")
+}
+
+// logFailedGroupVersions warns about group versions which could not be discovered, they are not compared
+func logFailedGroupVersions(clusterName string, surface *common.APISurface) {
+	groupVersions := make([]string, 0, len(surface.FailedGroupVersions))
+	for groupVersion := range surface.FailedGroupVersions {
+		groupVersions = append(groupVersions, groupVersion)
+	}
+	sort.Strings(groupVersions)
+
+	for _, groupVersion := range groupVersions {
+		log.Warnf("API '%s' of %s cluster cannot be discovered and is not compared: %s", groupVersion, clusterName, surface.FailedGroupVersions[groupVersion].Error())
+	}
+}
+
+// getGroupVersions returns sorted group versions served or failed to be discovered in any of the clusters
+func getGroupVersions(surface1, surface2 *common.APISurface) []string {
+	set := make(map[string]struct{})
+
+	for _, surface := range []*common.APISurface{surface1, surface2} {
+		for groupVersion := range surface.Resources {
+			set[groupVersion] = struct{}{}
+		}
+		for groupVersion := range surface.FailedGroupVersions {
+			set[groupVersion] = struct{}{}
+		}
+	}
+
+	groupVersions := make([]string, 0, len(set))
+	for groupVersion := range set {
+		groupVersions = append(groupVersions, groupVersion)
+	}
+	sort.Strings(groupVersions)
+
+	return groupVersions
+}
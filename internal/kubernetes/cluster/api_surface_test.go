@@ -0,0 +1,94 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+	"k8s-cluster-comparator/internal/logging"
+)
+
+func newClientSet(major, minor, gitVersion string, resourceLists ...*metav1.APIResourceList) *fake.Clientset {
+	clientSet := fake.NewSimpleClientset()
+
+	fakeDiscovery := clientSet.Discovery().(*fakediscovery.FakeDiscovery)
+	fakeDiscovery.FakedServerVersion = &version.Info{Major: major, Minor: minor, GitVersion: gitVersion}
+	fakeDiscovery.Resources = resourceLists
+
+	return clientSet
+}
+
+func newResourceList(groupVersion string, resources ...string) *metav1.APIResourceList {
+	list := &metav1.APIResourceList{GroupVersion: groupVersion}
+	for _, resource := range resources {
+		list.APIResources = append(list.APIResources, metav1.APIResource{Name: resource})
+	}
+
+	return list
+}
+
+func TestCompareAPISurfaces(t *testing.T) {
+	if err := logging.Configure(false); err != nil {
+		t.Fatalf("cannot configure logging: %s", err.Error())
+	}
+	if err := Init(context.Background()); err != nil {
+		t.Fatalf("cannot init cluster package: %s", err.Error())
+	}
+
+	clientSet1 := newClientSet("1", "17+", "v1.17.17-gke.1500",
+		newResourceList("batch/v1", "jobs", "jobs/status"),
+		newResourceList("batch/v1beta1", "cronjobs"),
+	)
+	clientSet2 := newClientSet("1", "17", "v1.17.15",
+		newResourceList("batch/v1", "jobs"),
+		newResourceList("batch/v1beta1", "cronjobs", "cronjobs/status"),
+	)
+
+	isDiffer, err := CompareAPISurfaces(clientSet1, clientSet2, nil)
+	if err != nil {
+		t.Fatalf("cannot compare API surfaces: %s", err.Error())
+	}
+	if isDiffer {
+		t.Error("Clusters differing in patch versions and subresources only are reported as different")
+	}
+
+	// comparers pick versioned clients from the discovered API surfaces
+	groupVersion, err := common.GetServedGroupVersion(clientSet1, "cronjobs", "batch/v1", "batch/v1beta1")
+	if err != nil || groupVersion != "batch/v1beta1" {
+		t.Errorf("Group version 'batch/v1beta1' expected for cronJobs. But it was returned: '%s', %v", groupVersion, err)
+	}
+
+	clientSet2 = newClientSet("1", "25", "v1.25.3",
+		newResourceList("batch/v1", "jobs", "cronjobs"),
+		newResourceList("networking.k8s.io/v1", "ingresses", "ingressclasses"),
+	)
+
+	isDiffer, err = CompareAPISurfaces(clientSet1, clientSet2, nil)
+	if err != nil {
+		t.Fatalf("cannot compare API surfaces: %s", err.Error())
+	}
+	if !isDiffer {
+		t.Error("Clusters with different server versions and served APIs are not reported")
+	}
+
+	groupVersion, err = common.GetServedGroupVersion(clientSet2, "cronjobs", "batch/v1", "batch/v1beta1")
+	if err != nil || groupVersion != "batch/v1" {
+		t.Errorf("Group version 'batch/v1' expected for cronJobs. But it was returned: '%s', %v", groupVersion, err)
+	}
+}
+
+func TestCompareServerVersions(t *testing.T) {
+	version1 := &version.Info{Major: "1", Minor: "17+", GitVersion: "v1.17.17-eks-c5067d"}
+	version2 := &version.Info{Major: "1", Minor: "19", GitVersion: "v1.19.16"}
+
+	err := compareServerVersions(version1, version2)
+	if !errors.Is(err, ErrorServerVersionsDifferent) {
+		t.Error("Error expected: 'the server versions of the clusters are different'. But it was returned: ", err)
+	}
+}
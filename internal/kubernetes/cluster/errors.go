@@ -0,0 +1,7 @@
+package cluster
+
+import "errors"
+
+var (
+	ErrorServerVersionsDifferent = errors.New("the server versions of the clusters are different")
+)
@@ -89,3 +89,17 @@ func ConvertMatchLabelsToString(matchLabels map[string]string) string {
 	}
 	return strings.Join(values, ",")
 }
+
+// SubtractStringSets returns sorted elements of the first set missing in the second one
+func SubtractStringSets(set1, set2 map[string]struct{}) []string {
+	result := make([]string, 0)
+
+	for key := range set1 {
+		if _, ok := set2[key]; !ok {
+			result = append(result, key)
+		}
+	}
+	sort.Strings(result)
+
+	return result
+}
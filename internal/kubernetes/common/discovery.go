@@ -0,0 +1,184 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes"
+)
+
+var (
+	ErrResourceNotServed = errors.New("resource is not served")
+
+	// apiSurfaces keeps discovered API surfaces of clusters, so versioned clients are chosen without querying the API server again
+	apiSurfaces      = make(map[kubernetes.Interface]*APISurface)
+	apiSurfacesMutex sync.RWMutex
+)
+
+// APISurface describes the server version and the resources served by a cluster
+type APISurface struct {
+	ServerVersion *version.Info
+
+	// Resources maps served group versions (e.g. "batch/v1beta1") to names of their resources, subresources are omitted
+	Resources map[string]map[string]struct{}
+
+	// FailedGroupVersions lists group versions which could not be discovered, e.g. unavailable aggregated APIs
+	FailedGroupVersions map[string]error
+}
+
+// IsForbidden checks whether the API server denied access, unlike apierrors.IsForbidden it accepts wrapped errors
+func IsForbidden(err error) bool {
+	var status apierrors.APIStatus
+
+	return errors.As(err, &status) && status.Status().Reason == metav1.StatusReasonForbidden
+}
+
+// DiscoverAPISurface obtains the server version and the resources served by the cluster and remembers them, so
+// IsResourceServed and GetServedGroupVersion answer without querying the API server again
+func DiscoverAPISurface(clientSet kubernetes.Interface) (*APISurface, error) {
+	serverVersion, err := clientSet.Discovery().ServerVersion()
+	if err != nil {
+		return nil, fmt.Errorf("cannot obtain server version: %w", err)
+	}
+
+	surface := &APISurface{
+		ServerVersion:       serverVersion,
+		Resources:           make(map[string]map[string]struct{}),
+		FailedGroupVersions: make(map[string]error),
+	}
+
+	_, resourceLists, err := clientSet.Discovery().ServerGroupsAndResources()
+	if err != nil {
+		var groupDiscoveryFailed *discovery.ErrGroupDiscoveryFailed
+		if !errors.As(err, &groupDiscoveryFailed) {
+			return nil, fmt.Errorf("cannot obtain served resources: %w", err)
+		}
+
+		for groupVersion, groupErr := range groupDiscoveryFailed.Groups {
+			surface.FailedGroupVersions[groupVersion.String()] = groupErr
+		}
+	}
+
+	for _, resourceList := range resourceLists {
+		if resourceList == nil {
+			continue
+		}
+
+		resources := make(map[string]struct{}, len(resourceList.APIResources))
+		for _, apiResource := range resourceList.APIResources {
+			if !strings.Contains(apiResource.Name, "/") {
+				resources[apiResource.Name] = struct{}{}
+			}
+		}
+		surface.Resources[resourceList.GroupVersion] = resources
+	}
+
+	apiSurfacesMutex.Lock()
+	apiSurfaces[clientSet] = surface
+	apiSurfacesMutex.Unlock()
+
+	return surface, nil
+}
+
+// getAPISurface returns the discovered API surface of the cluster or nil if it has not been discovered
+func getAPISurface(clientSet kubernetes.Interface) *APISurface {
+	apiSurfacesMutex.RLock()
+	defer apiSurfacesMutex.RUnlock()
+
+	return apiSurfaces[clientSet]
+}
+
+// IsServerVersionAtLeast checks whether the discovered server version of the cluster is not older than the given one.
+// Clusters which have not been discovered or report an unparsable version are assumed to be older
+func IsServerVersionAtLeast(clientSet kubernetes.Interface, major, minor int) bool {
+	surface := getAPISurface(clientSet)
+	if surface == nil || surface.ServerVersion == nil {
+		return false
+	}
+
+	// providers add suffixes to the minor version, e.g. '21+'
+	serverMajor, err := strconv.Atoi(strings.TrimRight(surface.ServerVersion.Major, "+"))
+	if err != nil {
+		return false
+	}
+	serverMinor, err := strconv.Atoi(strings.TrimRight(surface.ServerVersion.Minor, "+"))
+	if err != nil {
+		return false
+	}
+
+	return serverMajor > major || serverMajor == major && serverMinor >= minor
+}
+
+// IsResourceServed checks whether a cluster serves the resource in the given group version (e.g. "discovery.k8s.io/v1beta1", "endpointslices").
+// The discovered API surface of the cluster is used when it is available
+func IsResourceServed(clientSet kubernetes.Interface, groupVersion, resource string) (bool, error) {
+	if surface := getAPISurface(clientSet); surface != nil {
+		if _, isFailed := surface.FailedGroupVersions[groupVersion]; !isFailed {
+			_, isServed := surface.Resources[groupVersion][resource]
+			return isServed, nil
+		}
+	}
+
+	resources, err := clientSet.Discovery().ServerResourcesForGroupVersion(groupVersion)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	for _, apiResource := range resources.APIResources {
+		if apiResource.Name == resource {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// GetServedGroupVersion returns the first group version from the list in which the cluster serves the resource
+func GetServedGroupVersion(clientSet kubernetes.Interface, resource string, groupVersions ...string) (string, error) {
+	for _, groupVersion := range groupVersions {
+		isServed, err := IsResourceServed(clientSet, groupVersion, resource)
+		if err != nil {
+			return "", fmt.Errorf("cannot discover '%s' in '%s': %w", resource, groupVersion, err)
+		}
+
+		if isServed {
+			return groupVersion, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: '%s' in any of %s", ErrResourceNotServed, resource, strings.Join(groupVersions, ", "))
+}
+
+// GetRawResourceList fetches a list of resources of any served group version and decodes it into the given structure.
+// It allows working with API versions which are unknown to the vendored client-go
+func GetRawResourceList(clientSet kubernetes.Interface, groupVersion, namespace, resource string, into interface{}) error {
+	path := "/apis/" + groupVersion
+	if groupVersion == "v1" {
+		path = "/api/v1"
+	}
+
+	if namespace != "" {
+		path += "/namespaces/" + namespace
+	}
+
+	path += "/" + resource
+
+	data, err := clientSet.Discovery().RESTClient().Get().AbsPath(path).DoRaw()
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, into)
+}
@@ -6,11 +6,26 @@ import (
 	"k8s-cluster-comparator/internal/kubernetes/jobs"
 	"sync"
 
+	"k8s.io/client-go/kubernetes"
+
 	"k8s-cluster-comparator/internal/config"
+	"k8s-cluster-comparator/internal/kubernetes/autoscaling"
+	"k8s-cluster-comparator/internal/kubernetes/cluster"
+	"k8s-cluster-comparator/internal/kubernetes/common"
+	"k8s-cluster-comparator/internal/kubernetes/extensions"
 	"k8s-cluster-comparator/internal/kubernetes/kv_maps"
+	"k8s-cluster-comparator/internal/kubernetes/namespaces"
 	"k8s-cluster-comparator/internal/kubernetes/networking"
+	"k8s-cluster-comparator/internal/kubernetes/nodes"
 	"k8s-cluster-comparator/internal/kubernetes/pod_controllers"
+	"k8s-cluster-comparator/internal/kubernetes/policy"
+	"k8s-cluster-comparator/internal/kubernetes/quotas"
+	"k8s-cluster-comparator/internal/kubernetes/rbac"
+	"k8s-cluster-comparator/internal/kubernetes/scheduling"
+	"k8s-cluster-comparator/internal/kubernetes/skipper"
+	"k8s-cluster-comparator/internal/kubernetes/storage"
 	"k8s-cluster-comparator/internal/kubernetes/types"
+	"k8s-cluster-comparator/internal/logging"
 )
 
 // CompareClusters main compare function, runs functions for comparing clusters by different parameters one at a time: Deployments, StatefulSets, DaemonSets, ConfigMaps
@@ -23,12 +38,12 @@ func CompareClusters(ctx context.Context, cfg *config.AppConfig) (bool, error) {
 	var (
 		wg = &sync.WaitGroup{}
 
-		resCh = make(chan ResStr, len(cfg.Namespaces))
-
 		clientSet1 = cfg.Cluster1.Kubeconfig
 		clientSet2 = cfg.Cluster2.Kubeconfig
 	)
 
+	ctx = config.WithConfig(ctx, cfg)
+
 	if err := pod_controllers.Init(ctx); err != nil {
 		return false, fmt.Errorf("cannot init pod_controllers package: %w", err)
 	}
@@ -41,6 +56,64 @@ func CompareClusters(ctx context.Context, cfg *config.AppConfig) (bool, error) {
 	if err := jobs.Init(ctx); err != nil {
 		return false, fmt.Errorf("cannot init jobs package: %w", err)
 	}
+	if err := storage.Init(ctx); err != nil {
+		return false, fmt.Errorf("cannot init storage package: %w", err)
+	}
+	if err := rbac.Init(ctx); err != nil {
+		return false, fmt.Errorf("cannot init rbac package: %w", err)
+	}
+	if err := autoscaling.Init(ctx); err != nil {
+		return false, fmt.Errorf("cannot init autoscaling package: %w", err)
+	}
+	if err := policy.Init(ctx); err != nil {
+		return false, fmt.Errorf("cannot init policy package: %w", err)
+	}
+	if err := quotas.Init(ctx); err != nil {
+		return false, fmt.Errorf("cannot init quotas package: %w", err)
+	}
+	if err := namespaces.Init(ctx); err != nil {
+		return false, fmt.Errorf("cannot init namespaces package: %w", err)
+	}
+	if err := scheduling.Init(ctx); err != nil {
+		return false, fmt.Errorf("cannot init scheduling package: %w", err)
+	}
+	if err := extensions.Init(ctx); err != nil {
+		return false, fmt.Errorf("cannot init extensions package: %w", err)
+	}
+	if err := nodes.Init(ctx); err != nil {
+		return false, fmt.Errorf("cannot init nodes package: %w", err)
+	}
+	if err := cluster.Init(ctx); err != nil {
+		return false, fmt.Errorf("cannot init cluster package: %w", err)
+	}
+
+	if cfg.AllNamespaces {
+		resolved, err := namespaces.ResolveNamespaces(clientSet1, clientSet2, &cfg.NamespaceFilter)
+		if err != nil {
+			return false, err
+		}
+		cfg.Namespaces = resolved
+	}
+
+	isClusterScopeDiffer, err := compareClusterScope(ctx, cfg)
+	if err != nil {
+		return false, err
+	}
+
+	var snapshot1, snapshot2 *rbac.RBACSnapshot
+	if cfg.ComparePermissions {
+		snapshot1, err = rbac.GetRBACSnapshot(clientSet1)
+		if err != nil {
+			return false, fmt.Errorf("cannot obtain RBAC objects from 1st cluster: %w", err)
+		}
+
+		snapshot2, err = rbac.GetRBACSnapshot(clientSet2)
+		if err != nil {
+			return false, fmt.Errorf("cannot obtain RBAC objects from 2nd cluster: %w", err)
+		}
+	}
+
+	resCh := make(chan ResStr, len(cfg.Namespaces))
 
 	for _, namespace := range cfg.Namespaces {
 		wg.Add(1)
@@ -54,7 +127,23 @@ func CompareClusters(ctx context.Context, cfg *config.AppConfig) (bool, error) {
 				wg.Done()
 			}()
 
-			isClustersDiffer, err := pod_controllers.CompareDeployments(clientSet1, clientSet2, namespace, cfg.SkipEntitiesList)
+			autoscalers1, err := autoscaling.GetAutoscalers(clientSet1, namespace)
+			if err != nil {
+				resCh <- ResStr{
+					Err: fmt.Errorf("cannot obtain horizontal pod autoscalers from 1st cluster: %w", err),
+				}
+				return
+			}
+
+			autoscalers2, err := autoscaling.GetAutoscalers(clientSet2, namespace)
+			if err != nil {
+				resCh <- ResStr{
+					Err: fmt.Errorf("cannot obtain horizontal pod autoscalers from 2nd cluster: %w", err),
+				}
+				return
+			}
+
+			isClustersDiffer, err := pod_controllers.CompareDeployments(clientSet1, clientSet2, autoscalers1, autoscalers2, namespace, cfg.SkipEntitiesList)
 			if err != nil {
 				resCh <- ResStr{
 					IsClustersDiffer: isClustersDiffer,
@@ -64,7 +153,7 @@ func CompareClusters(ctx context.Context, cfg *config.AppConfig) (bool, error) {
 			}
 			isClustersDifferFlag.SetFlag(isClustersDiffer)
 
-			isClustersDiffer, err = pod_controllers.CompareStateFulSets(clientSet1, clientSet2, namespace, cfg.SkipEntitiesList)
+			isClustersDiffer, err = pod_controllers.CompareStateFulSets(clientSet1, clientSet2, autoscalers1, autoscalers2, namespace, cfg.SkipEntitiesList)
 			if err != nil {
 				resCh <- ResStr{
 					IsClustersDiffer: isClustersDiffer,
@@ -84,6 +173,18 @@ func CompareClusters(ctx context.Context, cfg *config.AppConfig) (bool, error) {
 			}
 			isClustersDifferFlag.SetFlag(isClustersDiffer)
 
+			isClustersDifferFlag.SetFlag(autoscaling.CompareHorizontalPodAutoscalers(autoscalers1, autoscalers2, cfg.SkipEntitiesList))
+
+			isClustersDiffer, err = policy.ComparePodDisruptionBudgets(clientSet1, clientSet2, namespace, cfg.SkipEntitiesList)
+			if err != nil {
+				resCh <- ResStr{
+					IsClustersDiffer: isClustersDiffer,
+					Err:              err,
+				}
+				return
+			}
+			isClustersDifferFlag.SetFlag(isClustersDiffer)
+
 			isClustersDiffer, err = kv_maps.CompareConfigMaps(clientSet1, clientSet2, namespace, cfg.SkipEntitiesList)
 			if err != nil {
 				resCh <- ResStr{
@@ -114,6 +215,18 @@ func CompareClusters(ctx context.Context, cfg *config.AppConfig) (bool, error) {
 			}
 			isClustersDifferFlag.SetFlag(isClustersDiffer)
 
+			if cfg.CompareEndpoints {
+				isClustersDiffer, err = networking.CompareServiceEndpoints(clientSet1, clientSet2, namespace, cfg.SkipEntitiesList)
+				if err != nil {
+					resCh <- ResStr{
+						IsClustersDiffer: isClustersDiffer,
+						Err:              err,
+					}
+					return
+				}
+				isClustersDifferFlag.SetFlag(isClustersDiffer)
+			}
+
 			isClustersDiffer, err = networking.CompareIngresses(clientSet1, clientSet2, namespace, cfg.SkipEntitiesList)
 			if err != nil {
 				resCh <- ResStr{
@@ -124,6 +237,16 @@ func CompareClusters(ctx context.Context, cfg *config.AppConfig) (bool, error) {
 			}
 			isClustersDifferFlag.SetFlag(isClustersDiffer)
 
+			isClustersDiffer, err = networking.CompareNetworkPolicies(clientSet1, clientSet2, namespace, cfg.SkipEntitiesList)
+			if err != nil {
+				resCh <- ResStr{
+					IsClustersDiffer: isClustersDiffer,
+					Err:              err,
+				}
+				return
+			}
+			isClustersDifferFlag.SetFlag(isClustersDiffer)
+
 			isClustersDiffer, err = jobs.CompareJobs(clientSet1, clientSet2, namespace, cfg.SkipEntitiesList)
 			if err != nil {
 				resCh <- ResStr{
@@ -144,6 +267,78 @@ func CompareClusters(ctx context.Context, cfg *config.AppConfig) (bool, error) {
 			}
 			isClustersDifferFlag.SetFlag(isClustersDiffer)
 
+			isClustersDiffer, err = storage.ComparePersistentVolumeClaims(clientSet1, clientSet2, namespace, cfg.SkipEntitiesList)
+			if err != nil {
+				resCh <- ResStr{
+					IsClustersDiffer: isClustersDiffer,
+					Err:              err,
+				}
+				return
+			}
+			isClustersDifferFlag.SetFlag(isClustersDiffer)
+
+			isClustersDiffer, err = quotas.CompareResourceQuotas(clientSet1, clientSet2, namespace, cfg.SkipEntitiesList)
+			if err != nil {
+				resCh <- ResStr{
+					IsClustersDiffer: isClustersDiffer,
+					Err:              err,
+				}
+				return
+			}
+			isClustersDifferFlag.SetFlag(isClustersDiffer)
+
+			isClustersDiffer, err = quotas.CompareLimitRanges(clientSet1, clientSet2, namespace, cfg.SkipEntitiesList)
+			if err != nil {
+				resCh <- ResStr{
+					IsClustersDiffer: isClustersDiffer,
+					Err:              err,
+				}
+				return
+			}
+			isClustersDifferFlag.SetFlag(isClustersDiffer)
+
+			isClustersDiffer, err = rbac.CompareServiceAccounts(clientSet1, clientSet2, namespace, cfg.SkipEntitiesList)
+			if err != nil {
+				resCh <- ResStr{
+					IsClustersDiffer: isClustersDiffer,
+					Err:              err,
+				}
+				return
+			}
+			isClustersDifferFlag.SetFlag(isClustersDiffer)
+
+			isClustersDiffer, err = rbac.CompareRoles(clientSet1, clientSet2, namespace, cfg.SkipEntitiesList)
+			if err != nil {
+				resCh <- ResStr{
+					IsClustersDiffer: isClustersDiffer,
+					Err:              err,
+				}
+				return
+			}
+			isClustersDifferFlag.SetFlag(isClustersDiffer)
+
+			isClustersDiffer, err = rbac.CompareRoleBindings(clientSet1, clientSet2, namespace, cfg.SkipEntitiesList)
+			if err != nil {
+				resCh <- ResStr{
+					IsClustersDiffer: isClustersDiffer,
+					Err:              err,
+				}
+				return
+			}
+			isClustersDifferFlag.SetFlag(isClustersDiffer)
+
+			if cfg.ComparePermissions {
+				isClustersDiffer, err = rbac.CompareServiceAccountPermissions(clientSet1, clientSet2, snapshot1, snapshot2, namespace, cfg.SkipEntitiesList)
+				if err != nil {
+					resCh <- ResStr{
+						IsClustersDiffer: isClustersDiffer,
+						Err:              err,
+					}
+					return
+				}
+				isClustersDifferFlag.SetFlag(isClustersDiffer)
+			}
+
 			resCh <- ResStr{
 				Err:              nil,
 				IsClustersDiffer: isClustersDifferFlag.GetFlag(),
@@ -164,5 +359,79 @@ func CompareClusters(ctx context.Context, cfg *config.AppConfig) (bool, error) {
 		}
 	}
 
-	return false, nil
+	return isClusterScopeDiffer, nil
+}
+
+// clusterScopeComparer is a function comparing cluster-scoped objects of one kind
+type clusterScopeComparer struct {
+	kind    string
+	compare func(clientSet1, clientSet2 kubernetes.Interface, skipEntityList skipper.SkipEntitiesList) (bool, error)
+}
+
+// compareClusterScope runs functions for comparing cluster-scoped objects one at a time: server versions and served APIs,
+// Namespaces and StorageClasses, optionally CustomResourceDefinitions, IngressClasses, PriorityClasses, RuntimeClasses,
+// webhook configurations, cluster RBAC and node fleets. Served APIs are discovered first, so comparers of namespaced
+// objects pick versioned clients without querying the API servers again
+func compareClusterScope(ctx context.Context, cfg *config.AppConfig) (bool, error) {
+	var (
+		log = logging.FromContext(ctx)
+
+		clientSet1 = cfg.Cluster1.Kubeconfig
+		clientSet2 = cfg.Cluster2.Kubeconfig
+
+		isClusterScopeDiffer bool
+
+		comparers = []clusterScopeComparer{
+			{kind: "served APIs", compare: cluster.CompareAPISurfaces},
+			{kind: "namespaces", compare: func(clientSet1, clientSet2 kubernetes.Interface, skipEntityList skipper.SkipEntitiesList) (bool, error) {
+				return namespaces.CompareNamespaces(clientSet1, clientSet2, cfg.Namespaces, skipEntityList)
+			}},
+			{kind: "storage classes", compare: storage.CompareStorageClasses},
+		}
+	)
+
+	if cfg.NamespaceSelector != nil {
+		comparers = append(comparers, clusterScopeComparer{kind: "namespace sets", compare: func(clientSet1, clientSet2 kubernetes.Interface, skipEntityList skipper.SkipEntitiesList) (bool, error) {
+			return namespaces.CompareNamespaceSets(clientSet1, clientSet2, cfg.NamespaceSelector, skipEntityList)
+		}})
+	}
+
+	if cfg.CompareClusterObjects {
+		comparers = append(comparers,
+			clusterScopeComparer{kind: "custom resource definitions", compare: extensions.CompareCustomResourceDefinitions},
+			clusterScopeComparer{kind: "ingress classes", compare: networking.CompareIngressClasses},
+			clusterScopeComparer{kind: "priority classes", compare: scheduling.ComparePriorityClasses},
+			clusterScopeComparer{kind: "runtime classes", compare: scheduling.CompareRuntimeClasses},
+			clusterScopeComparer{kind: "mutating webhook configurations", compare: extensions.CompareMutatingWebhookConfigurations},
+			clusterScopeComparer{kind: "validating webhook configurations", compare: extensions.CompareValidatingWebhookConfigurations},
+		)
+	}
+
+	if cfg.CompareClusterRBAC {
+		comparers = append(comparers,
+			clusterScopeComparer{kind: "cluster roles", compare: rbac.CompareClusterRoles},
+			clusterScopeComparer{kind: "cluster role bindings", compare: rbac.CompareClusterRoleBindings},
+		)
+	}
+
+	if cfg.CompareNodes {
+		comparers = append(comparers, clusterScopeComparer{kind: "nodes", compare: nodes.CompareNodeFleets})
+	}
+
+	for _, comparer := range comparers {
+		isClustersDiffer, err := comparer.compare(clientSet1, clientSet2, cfg.SkipEntitiesList)
+		if err != nil {
+			// denied access to objects of a kind is reported as a warning, so credentials lacking some cluster-wide
+			// permissions are still enough for comparing the other kinds and namespaced objects
+			if common.IsForbidden(err) {
+				log.Warnf("%s are not compared, access is forbidden: %s", comparer.kind, err.Error())
+				continue
+			}
+
+			return false, err
+		}
+		isClusterScopeDiffer = isClusterScopeDiffer || isClustersDiffer
+	}
+
+	return isClusterScopeDiffer, nil
 }
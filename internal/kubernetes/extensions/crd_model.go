@@ -0,0 +1,233 @@
+package extensions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+)
+
+const (
+	// maxReportedSchemaPaths limits the number of differing schema paths written to the report
+	maxReportedSchemaPaths = 5
+)
+
+var (
+	// crdGroupVersions lists group versions serving custom resource definitions from the most to the least preferred one
+	crdGroupVersions = []string{
+		"apiextensions.k8s.io/v1",
+		"apiextensions.k8s.io/v1beta1",
+	}
+)
+
+// crdList mirrors CustomResourceDefinitionList of apiextensions.k8s.io/v1 and apiextensions.k8s.io/v1beta1.
+// The apiextensions API is not vendored
+type crdList struct {
+	Items []crd `json:"items"`
+}
+
+// crd mirrors a CustomResourceDefinition of any served version
+type crd struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec crdSpec `json:"spec,omitempty"`
+}
+
+// crdSpec mirrors a CustomResourceDefinition spec. Validation and Subresources are set for all versions at once
+// in apiextensions.k8s.io/v1beta1 only
+type crdSpec struct {
+	Group    string       `json:"group"`
+	Names    crdNames     `json:"names"`
+	Scope    string       `json:"scope"`
+	Versions []crdVersion `json:"versions,omitempty"`
+
+	Validation   *crdValidation  `json:"validation,omitempty"`
+	Subresources json.RawMessage `json:"subresources,omitempty"`
+
+	Conversion *struct {
+		Strategy string `json:"strategy"`
+	} `json:"conversion,omitempty"`
+}
+
+// crdNames mirrors names of the custom resource
+type crdNames struct {
+	Kind       string   `json:"kind"`
+	Plural     string   `json:"plural"`
+	Singular   string   `json:"singular,omitempty"`
+	ShortNames []string `json:"shortNames,omitempty"`
+}
+
+// crdVersion mirrors a version of the custom resource
+type crdVersion struct {
+	Name       string `json:"name"`
+	Served     bool   `json:"served"`
+	Storage    bool   `json:"storage"`
+	Deprecated bool   `json:"deprecated,omitempty"`
+
+	Schema       *crdValidation  `json:"schema,omitempty"`
+	Subresources json.RawMessage `json:"subresources,omitempty"`
+}
+
+// crdValidation mirrors the schema of the custom resource
+type crdValidation struct {
+	OpenAPIV3Schema json.RawMessage `json:"openAPIV3Schema,omitempty"`
+}
+
+// getCustomResourceDefinitions returns custom resource definitions using the most preferred API version served by the cluster.
+// A cluster which does not serve custom resource definitions has none of them
+func getCustomResourceDefinitions(clientSet kubernetes.Interface) ([]crd, error) {
+	groupVersion, err := common.GetServedGroupVersion(clientSet, "customresourcedefinitions", crdGroupVersions...)
+	if errors.Is(err, common.ErrResourceNotServed) {
+		log.Debugf("custom resource definitions are not served by the cluster: %s", err.Error())
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("custom resource definitions are obtained using '%s' API", groupVersion)
+
+	list := crdList{}
+
+	if err := common.GetRawResourceList(clientSet, groupVersion, "", "customresourcedefinitions", &list); err != nil {
+		return nil, fmt.Errorf("cannot obtain custom resource definitions using '%s' API: %w", groupVersion, err)
+	}
+
+	return list.Items, nil
+}
+
+// formatNames returns names of the custom resource with short names regardless of their order
+func formatNames(names crdNames) string {
+	shortNames := append([]string{}, names.ShortNames...)
+	sort.Strings(shortNames)
+
+	return fmt.Sprintf("kind %s, plural %s, singular %s, short names [%s]", names.Kind, names.Plural, names.Singular, strings.Join(shortNames, ", "))
+}
+
+// formatVersions returns sorted versions of the custom resource with their served, storage and deprecated flags
+func formatVersions(spec crdSpec) string {
+	versions := make([]string, 0, len(spec.Versions))
+
+	for _, version := range spec.Versions {
+		flags := make([]string, 0, 3)
+		if version.Served {
+			flags = append(flags, "served")
+		}
+		if version.Storage {
+			flags = append(flags, "storage")
+		}
+		if version.Deprecated {
+			flags = append(flags, "deprecated")
+		}
+
+		versions = append(versions, fmt.Sprintf("%s (%s)", version.Name, strings.Join(flags, ", ")))
+	}
+	sort.Strings(versions)
+
+	return strings.Join(versions, "; ")
+}
+
+// getVersionSchema returns the schema of the version, the schema of the whole resource is used for
+// apiextensions.k8s.io/v1beta1 definitions
+func getVersionSchema(spec crdSpec, version crdVersion) json.RawMessage {
+	if version.Schema != nil {
+		return version.Schema.OpenAPIV3Schema
+	}
+
+	if spec.Validation != nil {
+		return spec.Validation.OpenAPIV3Schema
+	}
+
+	return nil
+}
+
+// getVersionSubresources returns the subresources of the version, the subresources of the whole resource are used for
+// apiextensions.k8s.io/v1beta1 definitions
+func getVersionSubresources(spec crdSpec, version crdVersion) json.RawMessage {
+	if len(version.Subresources) > 0 {
+		return version.Subresources
+	}
+
+	return spec.Subresources
+}
+
+// getConversionStrategy returns the conversion strategy, the API server defaults it to None
+func getConversionStrategy(spec crdSpec) string {
+	if spec.Conversion == nil || spec.Conversion.Strategy == "" {
+		return "None"
+	}
+
+	return spec.Conversion.Strategy
+}
+
+// diffJSONDocuments returns sorted paths at which two JSON documents differ. Documents are compared after decoding,
+// so the order of keys and the formatting do not matter
+func diffJSONDocuments(document1, document2 json.RawMessage) ([]string, error) {
+	var value1, value2 interface{}
+
+	if len(document1) > 0 {
+		if err := json.Unmarshal(document1, &value1); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(document2) > 0 {
+		if err := json.Unmarshal(document2, &value2); err != nil {
+			return nil, err
+		}
+	}
+
+	var paths []string
+	diffJSONValues("$", value1, value2, &paths)
+	sort.Strings(paths)
+
+	return paths, nil
+}
+
+// diffJSONValues appends paths at which two decoded JSON values differ
+func diffJSONValues(path string, value1, value2 interface{}, paths *[]string) {
+	object1, isObject1 := value1.(map[string]interface{})
+	object2, isObject2 := value2.(map[string]interface{})
+
+	if isObject1 && isObject2 {
+		for key, nested1 := range object1 {
+			diffJSONValues(path+"."+key, nested1, object2[key], paths)
+		}
+		for key, nested2 := range object2 {
+			if _, ok := object1[key]; !ok {
+				diffJSONValues(path+"."+key, nil, nested2, paths)
+			}
+		}
+		return
+	}
+
+	array1, isArray1 := value1.([]interface{})
+	array2, isArray2 := value2.([]interface{})
+
+	if isArray1 && isArray2 && len(array1) == len(array2) {
+		for index := range array1 {
+			diffJSONValues(fmt.Sprintf("%s[%d]", path, index), array1[index], array2[index], paths)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(value1, value2) {
+		*paths = append(*paths, path)
+	}
+}
+
+// formatSchemaPaths returns the first differing schema paths for the report
+func formatSchemaPaths(paths []string) string {
+	if len(paths) <= maxReportedSchemaPaths {
+		return strings.Join(paths, ", ")
+	}
+
+	return fmt.Sprintf("%s and %d more", strings.Join(paths[:maxReportedSchemaPaths], ", "), len(paths)-maxReportedSchemaPaths)
+}
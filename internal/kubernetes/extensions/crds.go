@@ -0,0 +1,187 @@
+package extensions
+
+import (
+	"fmt"
+	"sync"
+
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+	"k8s-cluster-comparator/internal/kubernetes/kv_maps"
+	"k8s-cluster-comparator/internal/kubernetes/skipper"
+	"k8s-cluster-comparator/internal/kubernetes/types"
+)
+
+// CompareCustomResourceDefinitions compares list of cluster-scoped custom resource definitions objects in two given k8s-clusters
+func CompareCustomResourceDefinitions(clientSet1, clientSet2 kubernetes.Interface, skipEntityList skipper.SkipEntitiesList) (bool, error) {
+	definitions1, err := getCustomResourceDefinitions(clientSet1)
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain custom resource definitions from 1st cluster: %w", err)
+	}
+
+	definitions2, err := getCustomResourceDefinitions(clientSet2)
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain custom resource definitions from 2nd cluster: %w", err)
+	}
+
+	mapDefinitions1, mapDefinitions2 := prepareCRDMaps(definitions1, definitions2, skipEntityList.GetByKind("customresourcedefinitions"))
+
+	return setInformationAboutCRDs(mapDefinitions1, mapDefinitions2, definitions1, definitions2), nil
+}
+
+// prepareCRDMaps add value custom resource definitions in map
+func prepareCRDMaps(definitions1, definitions2 []crd, skipEntities skipper.SkipComponentNames) (map[string]types.IsAlreadyComparedFlag, map[string]types.IsAlreadyComparedFlag) { //nolint:gocritic,unused
+	mapDefinitions1 := make(map[string]types.IsAlreadyComparedFlag)
+	mapDefinitions2 := make(map[string]types.IsAlreadyComparedFlag)
+	var indexCheck types.IsAlreadyComparedFlag
+
+	for index, value := range definitions1 {
+		if skipEntities.IsSkippedEntity(value.Name) {
+			log.Debugf("custom resource definition %s is skipped from comparison due to its name", value.Name)
+			continue
+		}
+		indexCheck.Index = index
+		mapDefinitions1[value.Name] = indexCheck
+	}
+	for index, value := range definitions2 {
+		if skipEntities.IsSkippedEntity(value.Name) {
+			log.Debugf("custom resource definition %s is skipped from comparison due to its name", value.Name)
+			continue
+		}
+		indexCheck.Index = index
+		mapDefinitions2[value.Name] = indexCheck
+	}
+
+	return mapDefinitions1, mapDefinitions2
+}
+
+// setInformationAboutCRDs set information about custom resource definitions
+func setInformationAboutCRDs(map1, map2 map[string]types.IsAlreadyComparedFlag, definitions1, definitions2 []crd) bool {
+	var (
+		flag bool
+	)
+
+	if len(map1) != len(map2) {
+		log.Infof("custom resource definitions counts are different")
+		flag = true
+	}
+
+	wg := &sync.WaitGroup{}
+	channel := make(chan bool, len(map1))
+
+	for name, index1 := range map1 {
+		if index2, ok := map2[name]; ok {
+			wg.Add(1)
+
+			index1.Check = true
+			map1[name] = index1
+			index2.Check = true
+			map2[name] = index2
+
+			go compareCRDSpecInternals(wg, channel, name, &definitions1[index1.Index], &definitions2[index2.Index])
+		} else {
+			log.Infof("custom resource definition '%s' does not exist in 2nd cluster", name)
+			flag = true
+			channel <- flag
+		}
+	}
+
+	wg.Wait()
+
+	close(channel)
+
+	for ch := range channel {
+		if ch {
+			flag = true
+		}
+	}
+	for name, index := range map2 {
+		if !index.Check {
+			log.Infof("custom resource definition '%s' does not exist in 1st cluster", name)
+			flag = true
+		}
+	}
+
+	return flag
+}
+
+func compareCRDSpecInternals(wg *sync.WaitGroup, channel chan bool, name string, definition1, definition2 *crd) {
+	var (
+		flag bool
+	)
+	defer func() {
+		wg.Done()
+	}()
+
+	log.Debugf("----- Start checking custom resource definition: '%s' -----", name)
+
+	if !kv_maps.AreKVMapsEqual(definition1.Labels, definition2.Labels, common.SkippedKubeLabels) {
+		log.Infof("metadata of custom resource definition '%s' differs: different labels", definition1.Name)
+		channel <- true
+		return
+	}
+
+	err := compareSpecInCRDs(definition1.Spec, definition2.Spec)
+	if err != nil {
+		log.Infof("CustomResourceDefinition %s: %s", name, err.Error())
+		flag = true
+	}
+
+	log.Debugf("----- End checking custom resource definition: '%s' -----", name)
+	channel <- flag
+}
+
+// compareSpecInCRDs compares the group, names, scope, versions and per-version schemas of custom resource definitions
+func compareSpecInCRDs(spec1, spec2 crdSpec) error {
+	if spec1.Group != spec2.Group {
+		return fmt.Errorf("%w. First definition: '%s'. Second definition: '%s'", ErrorCRDGroupDifferent, spec1.Group, spec2.Group)
+	}
+
+	names1, names2 := formatNames(spec1.Names), formatNames(spec2.Names)
+	if names1 != names2 {
+		return fmt.Errorf("%w. First definition: '%s'. Second definition: '%s'", ErrorCRDNamesDifferent, names1, names2)
+	}
+
+	if spec1.Scope != spec2.Scope {
+		return fmt.Errorf("%w. First definition: '%s'. Second definition: '%s'", ErrorCRDScopeDifferent, spec1.Scope, spec2.Scope)
+	}
+
+	versions1, versions2 := formatVersions(spec1), formatVersions(spec2)
+	if versions1 != versions2 {
+		return fmt.Errorf("%w. First definition: [%s]. Second definition: [%s]", ErrorCRDVersionsDifferent, versions1, versions2)
+	}
+
+	versionsMap2 := make(map[string]crdVersion, len(spec2.Versions))
+	for _, version := range spec2.Versions {
+		versionsMap2[version.Name] = version
+	}
+
+	for _, version1 := range spec1.Versions {
+		version2 := versionsMap2[version1.Name]
+
+		paths, err := diffJSONDocuments(getVersionSchema(spec1, version1), getVersionSchema(spec2, version2))
+		if err != nil {
+			return fmt.Errorf("cannot decode schema of version '%s': %w", version1.Name, err)
+		}
+
+		if len(paths) > 0 {
+			return fmt.Errorf("%w. Version '%s' differs at %s", ErrorCRDSchemaDifferent, version1.Name, formatSchemaPaths(paths))
+		}
+
+		paths, err = diffJSONDocuments(getVersionSubresources(spec1, version1), getVersionSubresources(spec2, version2))
+		if err != nil {
+			return fmt.Errorf("cannot decode subresources of version '%s': %w", version1.Name, err)
+		}
+
+		if len(paths) > 0 {
+			return fmt.Errorf("%w. Version '%s' differs at %s", ErrorCRDSubresourcesDifferent, version1.Name, formatSchemaPaths(paths))
+		}
+	}
+
+	conversion1, conversion2 := getConversionStrategy(spec1), getConversionStrategy(spec2)
+	if conversion1 != conversion2 {
+		return fmt.Errorf("%w. First definition: '%s'. Second definition: '%s'", ErrorCRDConversionDifferent, conversion1, conversion2)
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package extensions
+
+import "errors"
+
+var (
+	ErrorCRDGroupDifferent        = errors.New("the group in the custom resource definitions is different")
+	ErrorCRDNamesDifferent        = errors.New("the names in the custom resource definitions are different")
+	ErrorCRDScopeDifferent        = errors.New("the scope in the custom resource definitions is different")
+	ErrorCRDVersionsDifferent     = errors.New("the versions in the custom resource definitions are different")
+	ErrorCRDSchemaDifferent       = errors.New("the schema in the custom resource definitions is different")
+	ErrorCRDSubresourcesDifferent = errors.New("the subresources in the custom resource definitions are different")
+	ErrorCRDConversionDifferent   = errors.New("the conversion in the custom resource definitions is different")
+
+	ErrorWebhooksDifferent                 = errors.New("the webhooks in the webhook configurations are different")
+	ErrorWebhookClientConfigDifferent      = errors.New("the client config in the webhooks is different")
+	ErrorWebhookRulesDifferent             = errors.New("the rules in the webhooks are different")
+	ErrorWebhookFailurePolicyDifferent     = errors.New("the failure policy in the webhooks is different")
+	ErrorWebhookMatchPolicyDifferent       = errors.New("the match policy in the webhooks is different")
+	ErrorWebhookNamespaceSelectorDifferent = errors.New("the namespace selector in the webhooks is different")
+	ErrorWebhookObjectSelectorDifferent    = errors.New("the object selector in the webhooks is different")
+	ErrorWebhookSideEffectsDifferent       = errors.New("the side effects in the webhooks are different")
+	ErrorWebhookTimeoutDifferent           = errors.New("the timeout in the webhooks is different")
+	ErrorWebhookReviewVersionsDifferent    = errors.New("the admission review versions in the webhooks are different")
+	ErrorWebhookReinvocationDifferent      = errors.New("the reinvocation policy in the webhooks is different")
+)
@@ -0,0 +1,164 @@
+package extensions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	v1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"k8s-cluster-comparator/internal/logging"
+)
+
+const (
+	crdV1beta1 = `{"metadata": {"name": "crontabs.stable.example.com"}, "spec": {"group": "stable.example.com", "scope": "Namespaced",
+		"names": {"kind": "CronTab", "plural": "crontabs", "shortNames": ["ct", "cron"]},
+		"versions": [{"name": "v1", "served": true, "storage": true}],
+		"validation": {"openAPIV3Schema": {"type": "object", "properties": {"spec": {"type": "object", "properties": {"cronSpec": {"type": "string"}}}}}},
+		"subresources": {"status": {}}}}`
+	crdV1 = `{"metadata": {"name": "crontabs.stable.example.com"}, "spec": {"group": "stable.example.com", "scope": "Namespaced",
+		"names": {"kind": "CronTab", "plural": "crontabs", "shortNames": ["cron", "ct"]},
+		"versions": [{"name": "v1", "served": true, "storage": true, "subresources": {"status": {}},
+			"schema": {"openAPIV3Schema": {"properties": {"spec": {"properties": {"cronSpec": {"type": "string"}}, "type": "object"}}, "type": "object"}}}],
+		"conversion": {"strategy": "None"}}}`
+)
+
+func TestCompareSpecInCRDs(t *testing.T) {
+	var definition1, definition2 crd
+
+	if err := json.Unmarshal([]byte(crdV1beta1), &definition1); err != nil {
+		t.Fatalf("cannot decode custom resource definition: %s", err.Error())
+	}
+	if err := json.Unmarshal([]byte(crdV1), &definition2); err != nil {
+		t.Fatalf("cannot decode custom resource definition: %s", err.Error())
+	}
+
+	if err := compareSpecInCRDs(definition1.Spec, definition2.Spec); err != nil {
+		t.Error("Equal custom resource definitions of different API versions are reported as different: ", err)
+	}
+
+	changed := definition2
+	changed.Spec.Scope = "Cluster"
+	if err := compareSpecInCRDs(definition1.Spec, changed.Spec); !errors.Is(err, ErrorCRDScopeDifferent) {
+		t.Error("Error expected: 'the scope in the custom resource definitions is different'. But it was returned: ", err)
+	}
+
+	changed = definition2
+	changed.Spec.Versions = []crdVersion{definition2.Spec.Versions[0], {Name: "v2", Served: true}}
+	if err := compareSpecInCRDs(definition1.Spec, changed.Spec); !errors.Is(err, ErrorCRDVersionsDifferent) {
+		t.Error("Error expected: 'the versions in the custom resource definitions are different'. But it was returned: ", err)
+	}
+
+	changed = crd{}
+	if err := json.Unmarshal([]byte(crdV1), &changed); err != nil {
+		t.Fatalf("cannot decode custom resource definition: %s", err.Error())
+	}
+	changed.Spec.Versions[0].Schema.OpenAPIV3Schema = json.RawMessage(`{"type": "object", "properties": {"spec": {"type": "object", "properties": {"cronSpec": {"type": "integer"}}}}}`)
+
+	err := compareSpecInCRDs(definition1.Spec, changed.Spec)
+	if !errors.Is(err, ErrorCRDSchemaDifferent) {
+		t.Error("Error expected: 'the schema in the custom resource definitions is different'. But it was returned: ", err)
+	}
+	if expected := "the schema in the custom resource definitions is different. Version 'v1' differs at $.properties.spec.properties.cronSpec.type"; err != nil && err.Error() != expected {
+		t.Errorf("Error expected: '%s'. But it was returned: '%s'", expected, err.Error())
+	}
+
+	changed = definition2
+	changed.Spec.Conversion = nil
+	changed.Spec.Versions = []crdVersion{{Name: "v1", Served: true, Storage: true, Schema: definition2.Spec.Versions[0].Schema}}
+	if err := compareSpecInCRDs(definition1.Spec, changed.Spec); !errors.Is(err, ErrorCRDSubresourcesDifferent) {
+		t.Error("Error expected: 'the subresources in the custom resource definitions are different'. But it was returned: ", err)
+	}
+}
+
+func TestCompareWebhookConfigurations(t *testing.T) {
+	if err := logging.Configure(false); err != nil {
+		t.Fatalf("cannot configure logging: %s", err.Error())
+	}
+	if err := Init(context.Background()); err != nil {
+		t.Fatalf("cannot init extensions package: %s", err.Error())
+	}
+
+	var (
+		none   = v1.SideEffectClassNone
+		fail   = v1.Fail
+		ignore = v1.Ignore
+	)
+
+	newConfiguration := func(caBundle string, failurePolicy *v1.FailurePolicyType, rules ...v1.RuleWithOperations) v1.ValidatingWebhookConfiguration {
+		return v1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "policy"},
+			Webhooks: []v1.ValidatingWebhook{{
+				Name: "validate.example.com",
+				ClientConfig: v1.WebhookClientConfig{
+					Service:  &v1.ServiceReference{Namespace: "policy", Name: "webhook"},
+					CABundle: []byte(caBundle),
+				},
+				Rules:                   rules,
+				FailurePolicy:           failurePolicy,
+				SideEffects:             &none,
+				AdmissionReviewVersions: []string{"v1", "v1beta1"},
+			}},
+		}
+	}
+
+	newRule := func(resources ...string) v1.RuleWithOperations {
+		return v1.RuleWithOperations{
+			Operations: []v1.OperationType{v1.Create},
+			Rule:       v1.Rule{APIGroups: []string{"apps"}, APIVersions: []string{"v1"}, Resources: resources},
+		}
+	}
+
+	// webhook configurations are obtained with raw requests the fake clientset does not serve, so lists are compared directly
+	compareLists := func(list1, list2 *v1.ValidatingWebhookConfigurationList) bool {
+		configurations1, configurations2 := newValidatingWebhookConfigurations(list1), newValidatingWebhookConfigurations(list2)
+		map1, map2 := prepareWebhookConfigurationMaps(validatingWebhookConfigurationKind, configurations1, configurations2, nil)
+
+		return setInformationAboutWebhookConfigurations(validatingWebhookConfigurationKind, map1, map2, configurations1, configurations2)
+	}
+
+	list1 := &v1.ValidatingWebhookConfigurationList{Items: []v1.ValidatingWebhookConfiguration{newConfiguration("first-ca", nil, newRule("deployments", "statefulsets"))}}
+	list2 := &v1.ValidatingWebhookConfigurationList{Items: []v1.ValidatingWebhookConfiguration{newConfiguration("second-ca", &fail, newRule("statefulsets"), newRule("deployments"))}}
+
+	if compareLists(list1, list2) {
+		t.Error("Webhook configurations differing in CA bundles, defaulted fields and rule grouping only are reported as different")
+	}
+
+	list2 = &v1.ValidatingWebhookConfigurationList{Items: []v1.ValidatingWebhookConfiguration{newConfiguration("first-ca", &ignore, newRule("deployments", "statefulsets"))}}
+
+	if !compareLists(list1, list2) {
+		t.Error("Webhook configurations with different failure policies are not reported")
+	}
+
+	// admissionregistration.k8s.io/v1beta1 objects are decoded into v1 types
+	list2 = &v1.ValidatingWebhookConfigurationList{}
+	err := json.Unmarshal([]byte(`{"apiVersion": "admissionregistration.k8s.io/v1beta1", "items": [{"metadata": {"name": "policy"},
+		"webhooks": [{"name": "validate.example.com", "clientConfig": {"service": {"namespace": "policy", "name": "webhook"}, "caBundle": "c2Vjb25kLWNh"},
+			"rules": [{"operations": ["CREATE"], "apiGroups": ["apps"], "apiVersions": ["v1"], "resources": ["deployments", "statefulsets"]}],
+			"sideEffects": "None", "admissionReviewVersions": ["v1beta1", "v1"]}]}]}`), list2)
+	if err != nil {
+		t.Fatalf("cannot decode webhook configurations: %s", err.Error())
+	}
+
+	if compareLists(list1, list2) {
+		t.Error("Equal webhook configurations of different API versions are reported as different")
+	}
+}
+
+func TestCompareWebhooks(t *testing.T) {
+	webhook := webhookModel{Name: "mutate.example.com", ClientConfig: "url https://example.com/mutate", TimeoutSeconds: defaultWebhookTimeoutSeconds}
+
+	changed := webhook
+	changed.NamespaceSelector = "environment=production"
+	if err := compareWebhooks([]webhookModel{webhook}, []webhookModel{changed}); !errors.Is(err, ErrorWebhookNamespaceSelectorDifferent) {
+		t.Error("Error expected: 'the namespace selector in the webhooks is different'. But it was returned: ", err)
+	}
+
+	changed = webhook
+	changed.Name = "other.example.com"
+	if err := compareWebhooks([]webhookModel{webhook}, []webhookModel{changed}); !errors.Is(err, ErrorWebhooksDifferent) {
+		t.Error("Error expected: 'the webhooks in the webhook configurations are different'. But it was returned: ", err)
+	}
+}
@@ -0,0 +1,18 @@
+package extensions
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"k8s-cluster-comparator/internal/logging"
+)
+
+var (
+	log *zap.SugaredLogger
+)
+
+func Init(ctx context.Context) error {
+	log = logging.FromContext(ctx)
+	return nil
+}
@@ -0,0 +1,334 @@
+package extensions
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+)
+
+const (
+	// defaultWebhookTimeoutSeconds is applied by the API server when the timeout is not specified
+	defaultWebhookTimeoutSeconds = 10
+)
+
+var (
+	// webhookGroupVersions lists group versions serving webhook configurations from the most to the least preferred one
+	webhookGroupVersions = []string{
+		"admissionregistration.k8s.io/v1",
+		"admissionregistration.k8s.io/v1beta1",
+	}
+)
+
+// getMutatingWebhookConfigurations returns mutating webhook configurations using the most preferred API version served
+// by the cluster. admissionregistration.k8s.io/v1beta1 objects share the layout of v1 and are decoded into v1 types
+func getMutatingWebhookConfigurations(clientSet kubernetes.Interface) (*v1.MutatingWebhookConfigurationList, error) {
+	list := &v1.MutatingWebhookConfigurationList{}
+
+	if err := getWebhookConfigurations(clientSet, "mutatingwebhookconfigurations", list); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// getValidatingWebhookConfigurations returns validating webhook configurations using the most preferred API version served
+// by the cluster. admissionregistration.k8s.io/v1beta1 objects share the layout of v1 and are decoded into v1 types
+func getValidatingWebhookConfigurations(clientSet kubernetes.Interface) (*v1.ValidatingWebhookConfigurationList, error) {
+	list := &v1.ValidatingWebhookConfigurationList{}
+
+	if err := getWebhookConfigurations(clientSet, "validatingwebhookconfigurations", list); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// getWebhookConfigurations decodes webhook configurations of the resource into the list. A cluster which does not serve
+// the resource has none of them
+func getWebhookConfigurations(clientSet kubernetes.Interface, resource string, into interface{}) error {
+	groupVersion, err := common.GetServedGroupVersion(clientSet, resource, webhookGroupVersions...)
+	if errors.Is(err, common.ErrResourceNotServed) {
+		log.Debugf("%s are not served by the cluster: %s", resource, err.Error())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Debugf("%s are obtained using '%s' API", resource, groupVersion)
+
+	if err := common.GetRawResourceList(clientSet, groupVersion, "", resource, into); err != nil {
+		return fmt.Errorf("cannot obtain %s using '%s' API: %w", resource, groupVersion, err)
+	}
+
+	return nil
+}
+
+// webhookConfiguration is a representation of mutating and validating webhook configurations used for comparison
+type webhookConfiguration struct {
+	Name   string
+	Labels map[string]string
+
+	Webhooks []webhookModel
+}
+
+// webhookModel is a representation of a mutating or a validating webhook with the defaults of the API server applied.
+// The CA bundle is ignored as it is specific to every cluster
+type webhookModel struct {
+	Name string
+
+	ClientConfig string
+	Rules        []string
+
+	FailurePolicy      string
+	MatchPolicy        string
+	NamespaceSelector  string
+	ObjectSelector     string
+	SideEffects        string
+	TimeoutSeconds     int32
+	ReviewVersions     []string
+	ReinvocationPolicy string
+}
+
+// newMutatingWebhookConfigurations converts mutating webhook configurations to the comparison model
+func newMutatingWebhookConfigurations(list *v1.MutatingWebhookConfigurationList) []webhookConfiguration {
+	configurations := make([]webhookConfiguration, 0, len(list.Items))
+
+	for _, item := range list.Items {
+		configuration := webhookConfiguration{Name: item.Name, Labels: item.Labels}
+
+		for _, webhook := range item.Webhooks {
+			model := newWebhookModel(webhook.Name, webhook.ClientConfig, webhook.Rules, webhook.FailurePolicy, webhook.MatchPolicy,
+				webhook.NamespaceSelector, webhook.ObjectSelector, webhook.SideEffects, webhook.TimeoutSeconds, webhook.AdmissionReviewVersions)
+
+			model.ReinvocationPolicy = string(v1.NeverReinvocationPolicy)
+			if webhook.ReinvocationPolicy != nil {
+				model.ReinvocationPolicy = string(*webhook.ReinvocationPolicy)
+			}
+
+			configuration.Webhooks = append(configuration.Webhooks, model)
+		}
+
+		configurations = append(configurations, configuration)
+	}
+
+	return configurations
+}
+
+// newValidatingWebhookConfigurations converts validating webhook configurations to the comparison model
+func newValidatingWebhookConfigurations(list *v1.ValidatingWebhookConfigurationList) []webhookConfiguration {
+	configurations := make([]webhookConfiguration, 0, len(list.Items))
+
+	for _, item := range list.Items {
+		configuration := webhookConfiguration{Name: item.Name, Labels: item.Labels}
+
+		for _, webhook := range item.Webhooks {
+			configuration.Webhooks = append(configuration.Webhooks, newWebhookModel(webhook.Name, webhook.ClientConfig, webhook.Rules, webhook.FailurePolicy,
+				webhook.MatchPolicy, webhook.NamespaceSelector, webhook.ObjectSelector, webhook.SideEffects, webhook.TimeoutSeconds, webhook.AdmissionReviewVersions))
+		}
+
+		configurations = append(configurations, configuration)
+	}
+
+	return configurations
+}
+
+// newWebhookModel builds the comparison model of a webhook with the defaults of the API server applied
+func newWebhookModel(name string, clientConfig v1.WebhookClientConfig, rules []v1.RuleWithOperations, failurePolicy *v1.FailurePolicyType, matchPolicy *v1.MatchPolicyType,
+	namespaceSelector, objectSelector *metav1.LabelSelector, sideEffects *v1.SideEffectClass, timeoutSeconds *int32, reviewVersions []string) webhookModel {
+	model := webhookModel{
+		Name:              name,
+		ClientConfig:      formatClientConfig(clientConfig),
+		Rules:             formatRules(rules),
+		FailurePolicy:     string(v1.Fail),
+		MatchPolicy:       string(v1.Equivalent),
+		NamespaceSelector: formatWebhookSelector(namespaceSelector),
+		ObjectSelector:    formatWebhookSelector(objectSelector),
+		TimeoutSeconds:    defaultWebhookTimeoutSeconds,
+		ReviewVersions:    append([]string{}, reviewVersions...),
+	}
+
+	if failurePolicy != nil {
+		model.FailurePolicy = string(*failurePolicy)
+	}
+	if matchPolicy != nil {
+		model.MatchPolicy = string(*matchPolicy)
+	}
+	if sideEffects != nil {
+		model.SideEffects = string(*sideEffects)
+	}
+	if timeoutSeconds != nil {
+		model.TimeoutSeconds = *timeoutSeconds
+	}
+
+	sort.Strings(model.ReviewVersions)
+
+	return model
+}
+
+// formatClientConfig returns the service or the URL the webhook is called with, the CA bundle is ignored
+func formatClientConfig(clientConfig v1.WebhookClientConfig) string {
+	if clientConfig.URL != nil {
+		return "url " + *clientConfig.URL
+	}
+
+	if clientConfig.Service == nil {
+		return "<none>"
+	}
+
+	port := int32(443)
+	if clientConfig.Service.Port != nil {
+		port = *clientConfig.Service.Port
+	}
+
+	path := ""
+	if clientConfig.Service.Path != nil {
+		path = *clientConfig.Service.Path
+	}
+
+	return fmt.Sprintf("service %s/%s:%d%s", clientConfig.Service.Namespace, clientConfig.Service.Name, port, path)
+}
+
+// formatRules expands rules into sorted operation/group/version/resource tuples, so rules are equal regardless of
+// how they are grouped
+func formatRules(rules []v1.RuleWithOperations) []string {
+	tuples := make(map[string]struct{})
+
+	for _, rule := range rules {
+		scope := string(v1.AllScopes)
+		if rule.Scope != nil {
+			scope = string(*rule.Scope)
+		}
+
+		for _, operation := range rule.Operations {
+			for _, group := range rule.APIGroups {
+				for _, version := range rule.APIVersions {
+					for _, resource := range rule.Resources {
+						tuples[fmt.Sprintf("%s %s/%s/%s (scope %s)", operation, group, version, resource, scope)] = struct{}{}
+					}
+				}
+			}
+		}
+	}
+
+	result := make([]string, 0, len(tuples))
+	for tuple := range tuples {
+		result = append(result, tuple)
+	}
+	sort.Strings(result)
+
+	return result
+}
+
+// formatWebhookSelector returns the selector in the canonical form, an unset selector matches everything
+func formatWebhookSelector(selector *metav1.LabelSelector) string {
+	if selector == nil {
+		return "<all>"
+	}
+
+	result := metav1.FormatLabelSelector(selector)
+	if result == "<none>" {
+		return "<all>"
+	}
+
+	return result
+}
+
+// compareWebhooks compares webhooks of two configurations by their names
+func compareWebhooks(webhooks1, webhooks2 []webhookModel) error {
+	map1 := make(map[string]webhookModel, len(webhooks1))
+	for _, webhook := range webhooks1 {
+		map1[webhook.Name] = webhook
+	}
+
+	map2 := make(map[string]webhookModel, len(webhooks2))
+	for _, webhook := range webhooks2 {
+		map2[webhook.Name] = webhook
+	}
+
+	var onlyIn1, onlyIn2 []string
+	for name := range map1 {
+		if _, ok := map2[name]; !ok {
+			onlyIn1 = append(onlyIn1, name)
+		}
+	}
+	for name := range map2 {
+		if _, ok := map1[name]; !ok {
+			onlyIn2 = append(onlyIn2, name)
+		}
+	}
+
+	if len(onlyIn1) > 0 || len(onlyIn2) > 0 {
+		sort.Strings(onlyIn1)
+		sort.Strings(onlyIn2)
+		return fmt.Errorf("%w. Only in 1st cluster: [%s]. Only in 2nd cluster: [%s]", ErrorWebhooksDifferent, strings.Join(onlyIn1, ", "), strings.Join(onlyIn2, ", "))
+	}
+
+	names := make([]string, 0, len(map1))
+	for name := range map1 {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := compareWebhook(map1[name], map2[name]); err != nil {
+			return fmt.Errorf("webhook '%s': %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// compareWebhook compares a single webhook present in both configurations
+func compareWebhook(webhook1, webhook2 webhookModel) error {
+	if webhook1.ClientConfig != webhook2.ClientConfig {
+		return fmt.Errorf("%w. First webhook: '%s'. Second webhook: '%s'", ErrorWebhookClientConfigDifferent, webhook1.ClientConfig, webhook2.ClientConfig)
+	}
+
+	rules1, rules2 := strings.Join(webhook1.Rules, "; "), strings.Join(webhook2.Rules, "; ")
+	if rules1 != rules2 {
+		return fmt.Errorf("%w. First webhook: [%s]. Second webhook: [%s]", ErrorWebhookRulesDifferent, rules1, rules2)
+	}
+
+	if webhook1.FailurePolicy != webhook2.FailurePolicy {
+		return fmt.Errorf("%w. First webhook: '%s'. Second webhook: '%s'", ErrorWebhookFailurePolicyDifferent, webhook1.FailurePolicy, webhook2.FailurePolicy)
+	}
+
+	if webhook1.MatchPolicy != webhook2.MatchPolicy {
+		return fmt.Errorf("%w. First webhook: '%s'. Second webhook: '%s'", ErrorWebhookMatchPolicyDifferent, webhook1.MatchPolicy, webhook2.MatchPolicy)
+	}
+
+	if webhook1.NamespaceSelector != webhook2.NamespaceSelector {
+		return fmt.Errorf("%w. First webhook: '%s'. Second webhook: '%s'", ErrorWebhookNamespaceSelectorDifferent, webhook1.NamespaceSelector, webhook2.NamespaceSelector)
+	}
+
+	if webhook1.ObjectSelector != webhook2.ObjectSelector {
+		return fmt.Errorf("%w. First webhook: '%s'. Second webhook: '%s'", ErrorWebhookObjectSelectorDifferent, webhook1.ObjectSelector, webhook2.ObjectSelector)
+	}
+
+	if webhook1.SideEffects != webhook2.SideEffects {
+		return fmt.Errorf("%w. First webhook: '%s'. Second webhook: '%s'", ErrorWebhookSideEffectsDifferent, webhook1.SideEffects, webhook2.SideEffects)
+	}
+
+	if webhook1.TimeoutSeconds != webhook2.TimeoutSeconds {
+		return fmt.Errorf("%w. First webhook: %d. Second webhook: %d", ErrorWebhookTimeoutDifferent, webhook1.TimeoutSeconds, webhook2.TimeoutSeconds)
+	}
+
+	versions1, versions2 := strings.Join(webhook1.ReviewVersions, ", "), strings.Join(webhook2.ReviewVersions, ", ")
+	if versions1 != versions2 {
+		return fmt.Errorf("%w. First webhook: [%s]. Second webhook: [%s]", ErrorWebhookReviewVersionsDifferent, versions1, versions2)
+	}
+
+	if webhook1.ReinvocationPolicy != webhook2.ReinvocationPolicy {
+		return fmt.Errorf("%w. First webhook: '%s'. Second webhook: '%s'", ErrorWebhookReinvocationDifferent, webhook1.ReinvocationPolicy, webhook2.ReinvocationPolicy)
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package extensions
+
+import (
+	"fmt"
+	"sync"
+
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+	"k8s-cluster-comparator/internal/kubernetes/kv_maps"
+	"k8s-cluster-comparator/internal/kubernetes/skipper"
+	"k8s-cluster-comparator/internal/kubernetes/types"
+)
+
+const (
+	mutatingWebhookConfigurationKind   = "mutating webhook configuration"
+	validatingWebhookConfigurationKind = "validating webhook configuration"
+)
+
+// CompareMutatingWebhookConfigurations compares list of cluster-scoped mutating webhook configurations objects in two given k8s-clusters
+func CompareMutatingWebhookConfigurations(clientSet1, clientSet2 kubernetes.Interface, skipEntityList skipper.SkipEntitiesList) (bool, error) {
+	configurations1, err := getMutatingWebhookConfigurations(clientSet1)
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain mutating webhook configurations from 1st cluster: %w", err)
+	}
+
+	configurations2, err := getMutatingWebhookConfigurations(clientSet2)
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain mutating webhook configurations from 2nd cluster: %w", err)
+	}
+
+	webhookConfigurations1, webhookConfigurations2 := newMutatingWebhookConfigurations(configurations1), newMutatingWebhookConfigurations(configurations2)
+
+	mapConfigurations1, mapConfigurations2 := prepareWebhookConfigurationMaps(mutatingWebhookConfigurationKind, webhookConfigurations1, webhookConfigurations2, skipEntityList.GetByKind("mutatingwebhookconfigurations"))
+
+	return setInformationAboutWebhookConfigurations(mutatingWebhookConfigurationKind, mapConfigurations1, mapConfigurations2, webhookConfigurations1, webhookConfigurations2), nil
+}
+
+// CompareValidatingWebhookConfigurations compares list of cluster-scoped validating webhook configurations objects in two given k8s-clusters
+func CompareValidatingWebhookConfigurations(clientSet1, clientSet2 kubernetes.Interface, skipEntityList skipper.SkipEntitiesList) (bool, error) {
+	configurations1, err := getValidatingWebhookConfigurations(clientSet1)
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain validating webhook configurations from 1st cluster: %w", err)
+	}
+
+	configurations2, err := getValidatingWebhookConfigurations(clientSet2)
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain validating webhook configurations from 2nd cluster: %w", err)
+	}
+
+	webhookConfigurations1, webhookConfigurations2 := newValidatingWebhookConfigurations(configurations1), newValidatingWebhookConfigurations(configurations2)
+
+	mapConfigurations1, mapConfigurations2 := prepareWebhookConfigurationMaps(validatingWebhookConfigurationKind, webhookConfigurations1, webhookConfigurations2, skipEntityList.GetByKind("validatingwebhookconfigurations"))
+
+	return setInformationAboutWebhookConfigurations(validatingWebhookConfigurationKind, mapConfigurations1, mapConfigurations2, webhookConfigurations1, webhookConfigurations2), nil
+}
+
+// prepareWebhookConfigurationMaps add value webhook configurations in map
+func prepareWebhookConfigurationMaps(kind string, configurations1, configurations2 []webhookConfiguration, skipEntities skipper.SkipComponentNames) (map[string]types.IsAlreadyComparedFlag, map[string]types.IsAlreadyComparedFlag) { //nolint:gocritic,unused
+	mapConfigurations1 := make(map[string]types.IsAlreadyComparedFlag)
+	mapConfigurations2 := make(map[string]types.IsAlreadyComparedFlag)
+	var indexCheck types.IsAlreadyComparedFlag
+
+	for index, value := range configurations1 {
+		if skipEntities.IsSkippedEntity(value.Name) {
+			log.Debugf("%s %s is skipped from comparison due to its name", kind, value.Name)
+			continue
+		}
+		indexCheck.Index = index
+		mapConfigurations1[value.Name] = indexCheck
+	}
+	for index, value := range configurations2 {
+		if skipEntities.IsSkippedEntity(value.Name) {
+			log.Debugf("%s %s is skipped from comparison due to its name", kind, value.Name)
+			continue
+		}
+		indexCheck.Index = index
+		mapConfigurations2[value.Name] = indexCheck
+	}
+
+	return mapConfigurations1, mapConfigurations2
+}
+
+// setInformationAboutWebhookConfigurations set information about webhook configurations
+func setInformationAboutWebhookConfigurations(kind string, map1, map2 map[string]types.IsAlreadyComparedFlag, configurations1, configurations2 []webhookConfiguration) bool {
+	var (
+		flag bool
+	)
+
+	if len(map1) != len(map2) {
+		log.Infof("%s counts are different", kind)
+		flag = true
+	}
+
+	wg := &sync.WaitGroup{}
+	channel := make(chan bool, len(map1))
+
+	for name, index1 := range map1 {
+		if index2, ok := map2[name]; ok {
+			wg.Add(1)
+
+			index1.Check = true
+			map1[name] = index1
+			index2.Check = true
+			map2[name] = index2
+
+			go compareWebhookConfigurationSpecInternals(wg, channel, kind, name, &configurations1[index1.Index], &configurations2[index2.Index])
+		} else {
+			log.Infof("%s '%s' does not exist in 2nd cluster", kind, name)
+			flag = true
+			channel <- flag
+		}
+	}
+
+	wg.Wait()
+
+	close(channel)
+
+	for ch := range channel {
+		if ch {
+			flag = true
+		}
+	}
+	for name, index := range map2 {
+		if !index.Check {
+			log.Infof("%s '%s' does not exist in 1st cluster", kind, name)
+			flag = true
+		}
+	}
+
+	return flag
+}
+
+func compareWebhookConfigurationSpecInternals(wg *sync.WaitGroup, channel chan bool, kind, name string, configuration1, configuration2 *webhookConfiguration) {
+	var (
+		flag bool
+	)
+	defer func() {
+		wg.Done()
+	}()
+
+	log.Debugf("----- Start checking %s: '%s' -----", kind, name)
+
+	if !kv_maps.AreKVMapsEqual(configuration1.Labels, configuration2.Labels, common.SkippedKubeLabels) {
+		log.Infof("metadata of %s '%s' differs: different labels", kind, configuration1.Name)
+		channel <- true
+		return
+	}
+
+	err := compareWebhooks(configuration1.Webhooks, configuration2.Webhooks)
+	if err != nil {
+		log.Infof("%s %s: %s", kind, name, err.Error())
+		flag = true
+	}
+
+	log.Debugf("----- End checking %s: '%s' -----", kind, name)
+	channel <- flag
+}
@@ -6,8 +6,6 @@ import (
 	"k8s-cluster-comparator/internal/kubernetes/kv_maps"
 	"k8s-cluster-comparator/internal/kubernetes/skipper"
 	"k8s-cluster-comparator/internal/kubernetes/types"
-	"k8s.io/api/batch/v1beta1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"sync"
 )
@@ -17,14 +15,14 @@ func CompareCronJobs(clientSet1, clientSet2 kubernetes.Interface, namespace stri
 		isClustersDiffer bool
 	)
 
-	cronJobs1, err := clientSet1.BatchV1beta1().CronJobs(namespace).List(metav1.ListOptions{})
+	cronJobs1, err := getCronJobs(clientSet1, namespace)
 	if err != nil {
-		return false, fmt.Errorf("cannot obtain jobs list from 1st cluster: %w", err)
+		return false, fmt.Errorf("cannot obtain cronJobs list from 1st cluster: %w", err)
 	}
 
-	cronJobs2, err := clientSet2.BatchV1beta1().CronJobs(namespace).List(metav1.ListOptions{})
+	cronJobs2, err := getCronJobs(clientSet2, namespace)
 	if err != nil {
-		return false, fmt.Errorf("cannot obtain jobs list from 2nd cluster: %w", err)
+		return false, fmt.Errorf("cannot obtain cronJobs list from 2nd cluster: %w", err)
 	}
 
 	mapJobs1, mapJobs2 := prepareCronJobsMaps(cronJobs1, cronJobs2, skipEntityList.GetByKind("cronJobs"))
@@ -34,12 +32,12 @@ func CompareCronJobs(clientSet1, clientSet2 kubernetes.Interface, namespace stri
 	return isClustersDiffer, nil
 }
 
-func prepareCronJobsMaps(cronJobs1, cronJobs2 *v1beta1.CronJobList, skipEntities skipper.SkipComponentNames) (map[string]types.IsAlreadyComparedFlag, map[string]types.IsAlreadyComparedFlag) { //nolint:gocritic,unused
+func prepareCronJobsMaps(cronJobs1, cronJobs2 []cronJob, skipEntities skipper.SkipComponentNames) (map[string]types.IsAlreadyComparedFlag, map[string]types.IsAlreadyComparedFlag) { //nolint:gocritic,unused
 	mapCronJobs1 := make(map[string]types.IsAlreadyComparedFlag)
 	mapCronJobs2 := make(map[string]types.IsAlreadyComparedFlag)
 	var indexCheck types.IsAlreadyComparedFlag
 
-	for index, value := range cronJobs1.Items {
+	for index, value := range cronJobs1 {
 		if skipEntities.IsSkippedEntity(value.Name) {
 			log.Debugf("cronJob %s is skipped from comparison due to its name", value.Name)
 			continue
@@ -48,7 +46,7 @@ func prepareCronJobsMaps(cronJobs1, cronJobs2 *v1beta1.CronJobList, skipEntities
 		mapCronJobs1[value.Name] = indexCheck
 
 	}
-	for index, value := range cronJobs2.Items {
+	for index, value := range cronJobs2 {
 		if skipEntities.IsSkippedEntity(value.Name) {
 			log.Debugf("cronJob %s is skipped from comparison due to its name", value.Name)
 			continue
@@ -61,7 +59,7 @@ func prepareCronJobsMaps(cronJobs1, cronJobs2 *v1beta1.CronJobList, skipEntities
 }
 
 // setInformationAboutCronJobs set information about jobs
-func setInformationAboutCronJobs(map1, map2 map[string]types.IsAlreadyComparedFlag, cronJobs1, cronJobs2 *v1beta1.CronJobList, namespace string) bool {
+func setInformationAboutCronJobs(map1, map2 map[string]types.IsAlreadyComparedFlag, cronJobs1, cronJobs2 []cronJob, namespace string) bool {
 	var (
 		flag bool
 	)
@@ -83,7 +81,7 @@ func setInformationAboutCronJobs(map1, map2 map[string]types.IsAlreadyComparedFl
 			index2.Check = true
 			map2[name] = index2
 
-			compareCronJobSpecInternals(wg, channel, name, namespace, &cronJobs1.Items[index1.Index], &cronJobs2.Items[index2.Index])
+			compareCronJobSpecInternals(wg, channel, name, namespace, &cronJobs1[index1.Index], &cronJobs2[index2.Index])
 		} else {
 			log.Infof("cronJob '%s' does not exist in 2nd cluster", name)
 			flag = true
@@ -112,7 +110,7 @@ func setInformationAboutCronJobs(map1, map2 map[string]types.IsAlreadyComparedFl
 	return flag
 }
 
-func compareCronJobSpecInternals(wg *sync.WaitGroup, channel chan bool, name, namespace string, cronJob1, cronJob2 *v1beta1.CronJob) {
+func compareCronJobSpecInternals(wg *sync.WaitGroup, channel chan bool, name, namespace string, cronJob1, cronJob2 *cronJob) {
 	var (
 		flag bool
 	)
@@ -144,29 +142,52 @@ func compareCronJobSpecInternals(wg *sync.WaitGroup, channel chan bool, name, na
 	channel <- flag
 }
 
-func compareSpecInCronJobs(cronJob1, cronJob2 v1beta1.CronJob, namespace string) error {
+// compareSpecInCronJobs compares schedules, concurrency and history settings and job templates of cronJobs
+func compareSpecInCronJobs(cronJob1, cronJob2 cronJob, namespace string) error { //nolint:gocyclo
+	spec1, spec2 := cronJob1.Spec, cronJob2.Spec
 
-	if cronJob1.Spec.Schedule != cronJob2.Spec.Schedule {
-		return fmt.Errorf("%w. CronJob name: %s. CronJob 1 - %s, cronJob2 - %s ", ErrorScheduleDifferent, cronJob1.Name, cronJob1.Spec.Schedule, cronJob2.Spec.Schedule)
+	if semanticCronSchedules {
+		if !areCronSchedulesEquivalent(spec1.Schedule, spec2.Schedule) {
+			return fmt.Errorf("%w. CronJob name: %s. CronJob 1 - %s, cronJob2 - %s ", ErrorScheduleDifferent, cronJob1.Name, spec1.Schedule, spec2.Schedule)
+		}
+
+		if getEffectiveTimeZone(spec1) != getEffectiveTimeZone(spec2) {
+			return fmt.Errorf("%w. CronJob name: %s. CronJob 1 - '%s', cronJob2 - '%s'", ErrorTimeZoneDifferent, cronJob1.Name, getEffectiveTimeZone(spec1), getEffectiveTimeZone(spec2))
+		}
+	} else {
+		if spec1.Schedule != spec2.Schedule {
+			return fmt.Errorf("%w. CronJob name: %s. CronJob 1 - %s, cronJob2 - %s ", ErrorScheduleDifferent, cronJob1.Name, spec1.Schedule, spec2.Schedule)
+		}
+
+		if formatPtr(spec1.TimeZone) != formatPtr(spec2.TimeZone) {
+			return fmt.Errorf("%w. CronJob name: %s. CronJob 1 - %s, cronJob2 - %s", ErrorTimeZoneDifferent, cronJob1.Name, formatPtr(spec1.TimeZone), formatPtr(spec2.TimeZone))
+		}
+	}
+
+	if spec1.ConcurrencyPolicy != spec2.ConcurrencyPolicy {
+		return fmt.Errorf("%w. CronJob name: %s. CronJob 1 - %s, cronJob2 - %s", ErrorConcurrencyPolicyDifferent, cronJob1.Name, spec1.ConcurrencyPolicy, spec2.ConcurrencyPolicy)
 	}
 
-	err := compareSpecInJobs(cronJob1.Spec.JobTemplate.Spec, cronJob1.Spec.JobTemplate.Spec, namespace)
+	if !areBoolPtrsEqual(spec1.Suspend, spec2.Suspend) {
+		return fmt.Errorf("%w. CronJob name: %s. CronJob 1 - %s, cronJob2 - %s", ErrorSuspendDifferent, cronJob1.Name, formatPtr(spec1.Suspend), formatPtr(spec2.Suspend))
+	}
+
+	if !areInt64PtrsEqual(spec1.StartingDeadlineSeconds, spec2.StartingDeadlineSeconds) {
+		return fmt.Errorf("%w. CronJob name: %s. CronJob 1 - %s, cronJob2 - %s", ErrorStartingDeadlineSecondsDifferent, cronJob1.Name, formatPtr(spec1.StartingDeadlineSeconds), formatPtr(spec2.StartingDeadlineSeconds))
+	}
+
+	if !areInt32PtrsEqual(spec1.SuccessfulJobsHistoryLimit, spec2.SuccessfulJobsHistoryLimit) {
+		return fmt.Errorf("%w. CronJob name: %s. CronJob 1 - %s, cronJob2 - %s", ErrorSuccessfulJobsHistoryLimitDifferent, cronJob1.Name, formatPtr(spec1.SuccessfulJobsHistoryLimit), formatPtr(spec2.SuccessfulJobsHistoryLimit))
+	}
+
+	if !areInt32PtrsEqual(spec1.FailedJobsHistoryLimit, spec2.FailedJobsHistoryLimit) {
+		return fmt.Errorf("%w. CronJob name: %s. CronJob 1 - %s, cronJob2 - %s", ErrorFailedJobsHistoryLimitDifferent, cronJob1.Name, formatPtr(spec1.FailedJobsHistoryLimit), formatPtr(spec2.FailedJobsHistoryLimit))
+	}
+
+	err := compareSpecInJobs(spec1.JobTemplate.Spec, spec2.JobTemplate.Spec, namespace)
 	if err != nil {
 		return err
 	}
-	//castJob1ForCompareContainers := types.InformationAboutObject{
-	//	Template: job1.Spec.Template,
-	//	Selector: nil,
-	//}
-	//castJob2ForCompareContainers := types.InformationAboutObject{
-	//	Template: job2.Spec.Template,
-	//	Selector: nil,
-	//}
-	//
-	//err := pod_controllers.CompareContainers(castJob1ForCompareContainers, castJob2ForCompareContainers, "default",  true, true, nil, nil)
-	//if err != nil {
-	//	return err
-	//}
 
 	return nil
-}
\ No newline at end of file
+}
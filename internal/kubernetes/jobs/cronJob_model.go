@@ -0,0 +1,72 @@
+package jobs
+
+import (
+	"fmt"
+
+	"k8s.io/api/batch/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+)
+
+const (
+	cronJobGroupVersionBatchV1      = "batch/v1"
+	cronJobGroupVersionBatchV1beta1 = "batch/v1beta1"
+)
+
+var (
+	// cronJobGroupVersions lists group versions serving cronJobs from the most to the least preferred one
+	cronJobGroupVersions = []string{
+		cronJobGroupVersionBatchV1,
+		cronJobGroupVersionBatchV1beta1,
+	}
+)
+
+// cronJobList mirrors CronJobList of batch/v1 and batch/v1beta1 which share the same layout.
+// batch/v1 CronJobs and the timeZone field are unknown to the vendored client-go
+type cronJobList struct {
+	Items []cronJob `json:"items"`
+}
+
+// cronJob mirrors a CronJob of batch/v1 and batch/v1beta1
+type cronJob struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec cronJobSpec `json:"spec,omitempty"`
+}
+
+// cronJobSpec mirrors a CronJob spec extended with fields added in batch/v1
+type cronJobSpec struct {
+	v1beta1.CronJobSpec
+
+	// JobTemplate shadows the embedded job template to keep job spec fields unknown to the vendored client-go
+	JobTemplate jobTemplateSpec `json:"jobTemplate"`
+
+	TimeZone *string `json:"timeZone,omitempty"`
+}
+
+// jobTemplateSpec mirrors a job template of a CronJob
+type jobTemplateSpec struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec jobSpec `json:"spec,omitempty"`
+}
+
+// getCronJobs returns cronJobs of the namespace using the most preferred API version served by the cluster
+func getCronJobs(clientSet kubernetes.Interface, namespace string) ([]cronJob, error) {
+	groupVersion, err := common.GetServedGroupVersion(clientSet, "cronjobs", cronJobGroupVersions...)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("cronJobs are obtained using '%s' API", groupVersion)
+
+	list := cronJobList{}
+
+	if err := common.GetRawResourceList(clientSet, groupVersion, namespace, "cronjobs", &list); err != nil {
+		return nil, fmt.Errorf("cannot obtain cronJobs using '%s' API: %w", groupVersion, err)
+	}
+
+	return list.Items, nil
+}
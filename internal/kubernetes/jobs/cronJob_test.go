@@ -0,0 +1,113 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"k8s.io/api/batch/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"k8s-cluster-comparator/internal/kubernetes/pod_controllers"
+	"k8s-cluster-comparator/internal/logging"
+)
+
+func TestAreCronSchedulesEquivalent(t *testing.T) {
+	testCases := []struct {
+		schedule1, schedule2 string
+		equivalent           bool
+	}{
+		{"@daily", "0 0 * * *", true},
+		{"@midnight", "@daily", true},
+		{"*/15 * * * *", "0,15,30,45 * * * *", true},
+		{"0 9 * * 1-5", "0 9 * * MON-FRI", true},
+		{"0 0 * * 7", "0 0 * * sun", true},
+		{"5/20 * * * *", "5,25,45 * * * *", true},
+		{"0 0 * * *", "0 0 ? * *", true},
+		{"CRON_TZ=Europe/Moscow 0 0 * * *", "@daily", true},
+		{"0 0 * * *", "0 1 * * *", false},
+		{"0 0 1 * *", "0 0 1 * 1", false},
+		{"0 0 */1 * *", "0 0 1-31 * *", false},
+		{"not a schedule", "not a schedule", true},
+		{"not a schedule", "0 0 * * *", false},
+	}
+
+	for _, tc := range testCases {
+		if areCronSchedulesEquivalent(tc.schedule1, tc.schedule2) != tc.equivalent {
+			t.Errorf("schedules '%s' and '%s': expected equivalence %t", tc.schedule1, tc.schedule2, tc.equivalent)
+		}
+	}
+}
+
+func TestCompareSpecInCronJobs(t *testing.T) {
+	if err := logging.Configure(false); err != nil {
+		t.Fatalf("cannot configure logging: %s", err.Error())
+	}
+	if err := Init(context.Background()); err != nil {
+		t.Fatalf("cannot init jobs package: %s", err)
+	}
+	if err := pod_controllers.Init(context.Background()); err != nil {
+		t.Fatalf("cannot init pod_controllers package: %s", err)
+	}
+
+	newCronJob := func(schedule string) cronJob {
+		historyLimit := int32(3)
+
+		return cronJob{
+			ObjectMeta: metav1.ObjectMeta{Name: "backup"},
+			Spec: cronJobSpec{
+				CronJobSpec: v1beta1.CronJobSpec{
+					Schedule:                   schedule,
+					ConcurrencyPolicy:          v1beta1.AllowConcurrent,
+					SuccessfulJobsHistoryLimit: &historyLimit,
+				},
+			},
+		}
+	}
+
+	cronJob1, cronJob2 := newCronJob("@daily"), newCronJob("0 0 * * *")
+
+	semanticCronSchedules = false
+	if err := compareSpecInCronJobs(cronJob1, cronJob2, "default"); !errors.Is(err, ErrorScheduleDifferent) {
+		t.Error("Error expected: 'schedule in cronJobs is different'. But it was returned: ", err)
+	}
+
+	semanticCronSchedules = true
+	defer func() {
+		semanticCronSchedules = false
+	}()
+
+	if err := compareSpecInCronJobs(cronJob1, cronJob2, "default"); err != nil {
+		t.Error("Equivalent schedules are reported as different: ", err)
+	}
+
+	timeZone := "Europe/Moscow"
+	cronJob2.Spec.TimeZone = &timeZone
+	if err := compareSpecInCronJobs(cronJob1, cronJob2, "default"); !errors.Is(err, ErrorTimeZoneDifferent) {
+		t.Error("Error expected: 'time zone in cronJobs is different'. But it was returned: ", err)
+	}
+
+	cronJob1.Spec.Schedule = "CRON_TZ=Europe/Moscow 0 0 * * *"
+	if err := compareSpecInCronJobs(cronJob1, cronJob2, "default"); err != nil {
+		t.Error("Equal effective time zones are reported as different: ", err)
+	}
+
+	cronJob2.Spec.ConcurrencyPolicy = v1beta1.ForbidConcurrent
+	if err := compareSpecInCronJobs(cronJob1, cronJob2, "default"); !errors.Is(err, ErrorConcurrencyPolicyDifferent) {
+		t.Error("Error expected: 'concurrencyPolicy in cronJobs is different'. But it was returned: ", err)
+	}
+
+	cronJob2.Spec.ConcurrencyPolicy = v1beta1.AllowConcurrent
+	historyLimit := int32(10)
+	cronJob2.Spec.SuccessfulJobsHistoryLimit = &historyLimit
+	if err := compareSpecInCronJobs(cronJob1, cronJob2, "default"); !errors.Is(err, ErrorSuccessfulJobsHistoryLimitDifferent) {
+		t.Error("Error expected: 'successfulJobsHistoryLimit in cronJobs is different'. But it was returned: ", err)
+	}
+
+	cronJob2.Spec.SuccessfulJobsHistoryLimit = cronJob1.Spec.SuccessfulJobsHistoryLimit
+	suspend := true
+	cronJob2.Spec.Suspend = &suspend
+	if err := compareSpecInCronJobs(cronJob1, cronJob2, "default"); !errors.Is(err, ErrorSuspendDifferent) {
+		t.Error("Error expected: 'suspend in cronJobs is different'. But it was returned: ", err)
+	}
+}
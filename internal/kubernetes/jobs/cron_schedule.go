@@ -0,0 +1,231 @@
+package jobs
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	errInvalidCronSchedule = errors.New("invalid cron schedule")
+
+	// cronDescriptors maps predefined schedules to the equivalent standard expressions
+	cronDescriptors = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+
+	monthNames = map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}
+
+	dayOfWeekNames = map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}
+
+	// cronFields describes fields of a standard cron expression in their order
+	cronFields = []cronField{
+		{name: "minute", min: 0, max: 59},
+		{name: "hour", min: 0, max: 23},
+		{name: "day of month", min: 1, max: 31},
+		{name: "month", min: 1, max: 12, names: monthNames},
+		{name: "day of week", min: 0, max: 7, names: dayOfWeekNames},
+	}
+)
+
+// cronField describes the range of values of a cron expression field
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+// cronSchedule is a parsed cron expression. Each field is a set of matching values.
+// Day of month and day of week also keep whether they are unrestricted, because the
+// scheduler matches either of them when both are restricted
+type cronSchedule struct {
+	TimeZone string
+
+	Fields [5]uint64
+
+	DayOfMonthAny bool
+	DayOfWeekAny  bool
+}
+
+// parseCronSchedule parses a cron expression in the format accepted by the CronJob controller
+func parseCronSchedule(schedule string) (cronSchedule, error) {
+	var parsed cronSchedule
+
+	expression := strings.TrimSpace(schedule)
+
+	if strings.HasPrefix(expression, "CRON_TZ=") || strings.HasPrefix(expression, "TZ=") {
+		separator := strings.Index(expression, " ")
+		if separator == -1 {
+			return parsed, fmt.Errorf("%w: '%s'", errInvalidCronSchedule, schedule)
+		}
+
+		parsed.TimeZone = expression[strings.Index(expression, "=")+1 : separator]
+		expression = strings.TrimSpace(expression[separator:])
+	}
+
+	if strings.HasPrefix(expression, "@") {
+		standard, ok := cronDescriptors[strings.ToLower(expression)]
+		if !ok {
+			return parsed, fmt.Errorf("%w: unsupported descriptor '%s'", errInvalidCronSchedule, expression)
+		}
+		expression = standard
+	}
+
+	values := strings.Fields(expression)
+	if len(values) != len(cronFields) {
+		return parsed, fmt.Errorf("%w: expected %d fields, found %d in '%s'", errInvalidCronSchedule, len(cronFields), len(values), schedule)
+	}
+
+	for i, field := range cronFields {
+		bits, isAny, err := parseCronField(values[i], field)
+		if err != nil {
+			return parsed, fmt.Errorf("%w: %s field of '%s': %s", errInvalidCronSchedule, field.name, schedule, err.Error())
+		}
+
+		parsed.Fields[i] = bits
+
+		switch i {
+		case 2:
+			parsed.DayOfMonthAny = isAny
+		case 4:
+			parsed.DayOfWeekAny = isAny
+		}
+	}
+
+	// Sunday may be written as both 0 and 7
+	if parsed.Fields[4]&(1<<7) != 0 {
+		parsed.Fields[4] = parsed.Fields[4]&^(1<<7) | 1
+	}
+
+	return parsed, nil
+}
+
+// parseCronField returns the set of values matching a comma-separated cron field and whether the field is unrestricted
+func parseCronField(value string, field cronField) (uint64, bool, error) {
+	var (
+		bits  uint64
+		isAny bool
+	)
+
+	for _, part := range strings.Split(value, ",") {
+		rangeAndStep := strings.Split(part, "/")
+		if len(rangeAndStep) > 2 {
+			return 0, false, fmt.Errorf("too many slashes in '%s'", part)
+		}
+
+		start, end := field.min, field.max
+		isWildcard := rangeAndStep[0] == "*" || rangeAndStep[0] == "?"
+
+		if !isWildcard {
+			bounds := strings.Split(rangeAndStep[0], "-")
+			if len(bounds) > 2 {
+				return 0, false, fmt.Errorf("too many hyphens in '%s'", part)
+			}
+
+			var err error
+
+			start, err = parseCronValue(bounds[0], field)
+			if err != nil {
+				return 0, false, err
+			}
+
+			end = start
+			if len(bounds) == 2 {
+				end, err = parseCronValue(bounds[1], field)
+				if err != nil {
+					return 0, false, err
+				}
+			}
+		}
+
+		step := 1
+		if len(rangeAndStep) == 2 {
+			var err error
+
+			step, err = strconv.Atoi(rangeAndStep[1])
+			if err != nil || step <= 0 {
+				return 0, false, fmt.Errorf("invalid step in '%s'", part)
+			}
+
+			// "N/step" means "N-max/step"
+			if !isWildcard && !strings.Contains(rangeAndStep[0], "-") {
+				end = field.max
+			}
+		}
+
+		if start > end {
+			return 0, false, fmt.Errorf("beginning of range is beyond its end in '%s'", part)
+		}
+
+		if isWildcard && step == 1 {
+			isAny = true
+		}
+
+		for v := start; v <= end; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+
+	return bits, isAny, nil
+}
+
+// parseCronValue parses a number or a name of a cron field value
+func parseCronValue(value string, field cronField) (int, error) {
+	if number, ok := field.names[strings.ToLower(value)]; ok {
+		return number, nil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value '%s'", value)
+	}
+
+	if number < field.min || number > field.max {
+		return 0, fmt.Errorf("value %d is out of range [%d, %d]", number, field.min, field.max)
+	}
+
+	return number, nil
+}
+
+// areCronSchedulesEquivalent reports whether two cron expressions fire at the same moments regardless of
+// their time zone prefix, which is compared separately. Expressions which cannot be parsed are compared literally
+func areCronSchedulesEquivalent(schedule1, schedule2 string) bool {
+	parsed1, err1 := parseCronSchedule(schedule1)
+	parsed2, err2 := parseCronSchedule(schedule2)
+
+	if err1 != nil || err2 != nil {
+		return schedule1 == schedule2
+	}
+
+	parsed1.TimeZone, parsed2.TimeZone = "", ""
+
+	return parsed1 == parsed2
+}
+
+// getEffectiveTimeZone returns the time zone of a cronJob set either by the timeZone field or by a
+// CRON_TZ/TZ prefix of its schedule. An empty string means the time zone of the controller manager
+func getEffectiveTimeZone(spec cronJobSpec) string {
+	if spec.TimeZone != nil {
+		return *spec.TimeZone
+	}
+
+	parsed, err := parseCronSchedule(spec.Schedule)
+	if err != nil {
+		return ""
+	}
+
+	return parsed.TimeZone
+}
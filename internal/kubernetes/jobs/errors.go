@@ -6,5 +6,18 @@ var (
 	ErrorBackoffLimitDifferent  = errors.New("backoffLimit in jobs is different")
 	ErrorRestartPolicyDifferent = errors.New("restartPolicy in jobs is different")
 
-	ErrorScheduleDifferent = errors.New("schedule in cronJobs is different")
+	ErrorParallelismDifferent             = errors.New("parallelism in jobs is different")
+	ErrorCompletionsDifferent             = errors.New("completions in jobs is different")
+	ErrorCompletionModeDifferent          = errors.New("completionMode in jobs is different")
+	ErrorActiveDeadlineSecondsDifferent   = errors.New("activeDeadlineSeconds in jobs is different")
+	ErrorTTLSecondsAfterFinishedDifferent = errors.New("ttlSecondsAfterFinished in jobs is different")
+	ErrorJobOutcomeDifferent              = errors.New("outcome of jobs is different")
+
+	ErrorScheduleDifferent                   = errors.New("schedule in cronJobs is different")
+	ErrorTimeZoneDifferent                   = errors.New("time zone in cronJobs is different")
+	ErrorConcurrencyPolicyDifferent          = errors.New("concurrencyPolicy in cronJobs is different")
+	ErrorSuspendDifferent                    = errors.New("suspend in cronJobs is different")
+	ErrorStartingDeadlineSecondsDifferent    = errors.New("startingDeadlineSeconds in cronJobs is different")
+	ErrorSuccessfulJobsHistoryLimitDifferent = errors.New("successfulJobsHistoryLimit in cronJobs is different")
+	ErrorFailedJobsHistoryLimitDifferent     = errors.New("failedJobsHistoryLimit in cronJobs is different")
 )
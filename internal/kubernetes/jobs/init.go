@@ -5,14 +5,29 @@ import (
 
 	"go.uber.org/zap"
 
+	"k8s-cluster-comparator/internal/config"
 	"k8s-cluster-comparator/internal/logging"
 )
 
 var (
 	log *zap.SugaredLogger
+
+	semanticCronSchedules bool
+	compareJobOutcome     bool
+	jobMatchStrategy      = JobMatchByName
 )
 
 func Init(ctx context.Context) error {
 	log = logging.FromContext(ctx)
+
+	cfg := config.FromContext(ctx)
+
+	semanticCronSchedules = cfg.SemanticCronSchedules
+	compareJobOutcome = cfg.CompareJobOutcome
+
+	if cfg.JobMatchStrategy != "" {
+		jobMatchStrategy = cfg.JobMatchStrategy
+	}
+
 	return nil
-}
\ No newline at end of file
+}
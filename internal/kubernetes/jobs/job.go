@@ -2,15 +2,12 @@ package jobs
 
 import (
 	"fmt"
-	"k8s-cluster-comparator/internal/kubernetes/common"
 	"k8s-cluster-comparator/internal/kubernetes/kv_maps"
 	"k8s-cluster-comparator/internal/kubernetes/pod_controllers"
 	"sync"
 
 	"k8s-cluster-comparator/internal/kubernetes/skipper"
 	"k8s-cluster-comparator/internal/kubernetes/types"
-	v12 "k8s.io/api/batch/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -20,12 +17,12 @@ func CompareJobs(clientSet1, clientSet2 kubernetes.Interface, namespace string,
 		isClustersDiffer bool
 	)
 
-	jobs1, err := clientSet1.BatchV1().Jobs(namespace).List(metav1.ListOptions{})
+	jobs1, err := getJobs(clientSet1, namespace)
 	if err != nil {
 		return false, fmt.Errorf("cannot obtain jobs list from 1st cluster: %w", err)
 	}
 
-	jobs2, err := clientSet2.BatchV1().Jobs(namespace).List(metav1.ListOptions{})
+	jobs2, err := getJobs(clientSet2, namespace)
 	if err != nil {
 		return false, fmt.Errorf("cannot obtain jobs list from 2nd cluster: %w", err)
 	}
@@ -37,54 +34,13 @@ func CompareJobs(clientSet1, clientSet2 kubernetes.Interface, namespace string,
 	return isClustersDiffer, nil
 }
 
-// prepareJobsMaps add value secrets in map
-func prepareJobsMaps(jobs1, jobs2 *v12.JobList, skipEntities skipper.SkipComponentNames) (map[string]types.IsAlreadyComparedFlag, map[string]types.IsAlreadyComparedFlag) { //nolint:gocritic,unused
-	mapJobs1 := make(map[string]types.IsAlreadyComparedFlag)
-	mapJobs2 := make(map[string]types.IsAlreadyComparedFlag)
-	var indexCheck types.IsAlreadyComparedFlag
-
-	OUTER1:
-	for index, value := range jobs1.Items {
-		if skipEntities.IsSkippedEntity(value.Name) {
-			log.Debugf("job %s is skipped from comparison due to its name", value.Name)
-			continue
-		}
-		if value.OwnerReferences != nil {
-			for _, owner := range value.OwnerReferences {
-				if owner.Kind == "CronJob" {
-					log.Debugf("job %s is skipped from comparison due to its owner cronJob", value.Name)
-					continue OUTER1
-				}
-			}
-		}
-		indexCheck.Index = index
-		mapJobs1[value.Name] = indexCheck
-
-	}
-	OUTER2:
-	for index, value := range jobs2.Items {
-		if skipEntities.IsSkippedEntity(value.Name) {
-			log.Debugf("job %s is skipped from comparison due to its name", value.Name)
-			continue
-		}
-		if value.OwnerReferences != nil {
-			for _, owner := range value.OwnerReferences {
-				if owner.Kind == "CronJob" {
-					log.Debugf("job %s is skipped from comparison due to its owner cronJob", value.Name)
-					continue OUTER2
-				}
-			}
-
-		}
-		indexCheck.Index = index
-		mapJobs2[value.Name] = indexCheck
-
-	}
-	return mapJobs1, mapJobs2
+// prepareJobsMaps pairs jobs of both clusters by their match keys
+func prepareJobsMaps(jobs1, jobs2 []job, skipEntities skipper.SkipComponentNames) (map[string]types.IsAlreadyComparedFlag, map[string]types.IsAlreadyComparedFlag) { //nolint:gocritic,unused
+	return prepareJobsMap(jobs1, skipEntities), prepareJobsMap(jobs2, skipEntities)
 }
 
 // setInformationAboutJobs set information about jobs
-func setInformationAboutJobs(map1, map2 map[string]types.IsAlreadyComparedFlag, jobs1, jobs2 *v12.JobList, namespace string) bool {
+func setInformationAboutJobs(map1, map2 map[string]types.IsAlreadyComparedFlag, jobs1, jobs2 []job, namespace string) bool {
 	var (
 		flag bool
 	)
@@ -106,7 +62,7 @@ func setInformationAboutJobs(map1, map2 map[string]types.IsAlreadyComparedFlag,
 			index2.Check = true
 			map2[name] = index2
 
-			compareJobSpecInternals(wg, channel, name, namespace, &jobs1.Items[index1.Index], &jobs2.Items[index2.Index])
+			compareJobSpecInternals(wg, channel, name, namespace, &jobs1[index1.Index], &jobs2[index2.Index])
 		} else {
 			log.Infof("job '%s' does not exist in 2nd cluster", name)
 			flag = true
@@ -135,7 +91,7 @@ func setInformationAboutJobs(map1, map2 map[string]types.IsAlreadyComparedFlag,
 	return flag
 }
 
-func compareJobSpecInternals(wg *sync.WaitGroup, channel chan bool, name, namespace string, job1, job2 *v12.Job) {
+func compareJobSpecInternals(wg *sync.WaitGroup, channel chan bool, name, namespace string, job1, job2 *job) {
 	var (
 		flag bool
 	)
@@ -145,13 +101,13 @@ func compareJobSpecInternals(wg *sync.WaitGroup, channel chan bool, name, namesp
 
 	log.Debugf("----- Start checking job: '%s' -----", name)
 
-	if !kv_maps.AreKVMapsEqual(job1.ObjectMeta.Labels, job2.ObjectMeta.Labels, common.SkippedKubeLabels) {
+	if !kv_maps.AreKVMapsEqual(job1.ObjectMeta.Labels, job2.ObjectMeta.Labels, jobSkippedLabels) {
 		log.Infof("metadata of job '%s' differs: different labels", job1.Name)
 		channel <- true
 		return
 	}
 
-	if !kv_maps.AreKVMapsEqual(job1.ObjectMeta.Labels, job2.ObjectMeta.Labels, nil) {
+	if !kv_maps.AreKVMapsEqual(job1.ObjectMeta.Annotations, job2.ObjectMeta.Annotations, jobControllerAnnotations) {
 		log.Infof("metadata of job '%s' differs: different annotations", job2.Name)
 		channel <- true
 		return
@@ -163,18 +119,42 @@ func compareJobSpecInternals(wg *sync.WaitGroup, channel chan bool, name, namesp
 		flag = true
 	}
 
+	if compareJobOutcome {
+		outcome1, outcome2 := getJobOutcome(job1.Status), getJobOutcome(job2.Status)
+		if outcome1 != outcome2 {
+			log.Infof("Job %s: %s. Job 1 - %s, Job 2 - %s", name, ErrorJobOutcomeDifferent.Error(), outcome1, outcome2)
+			flag = true
+		}
+	}
+
 	log.Debugf("----- End checking job: '%s' -----", name)
 	channel <- flag
 }
 
-func compareSpecInJobs(job1, job2 v12.JobSpec, namespace string) error {
+// compareSpecInJobs compares parallelism, completion and retry settings and pod templates of jobs
+func compareSpecInJobs(job1, job2 jobSpec, namespace string) error { //nolint:gocyclo
+	if !areInt32PtrsEqual(job1.Parallelism, job2.Parallelism) {
+		return fmt.Errorf("%w. Job 1 - %s, Job 2 - %s", ErrorParallelismDifferent, formatPtr(job1.Parallelism), formatPtr(job2.Parallelism))
+	}
 
-	if job1.BackoffLimit != nil && job2.BackoffLimit != nil {
-		if *job1.BackoffLimit != *job2.BackoffLimit {
-			return fmt.Errorf("%w. Job 1 - %d, Job 2 - %d", ErrorBackoffLimitDifferent, &job1.BackoffLimit, &job2.BackoffLimit )
-		}
-	} else if job1.BackoffLimit != nil || job2.BackoffLimit != nil {
-		return ErrorBackoffLimitDifferent
+	if !areInt32PtrsEqual(job1.Completions, job2.Completions) {
+		return fmt.Errorf("%w. Job 1 - %s, Job 2 - %s", ErrorCompletionsDifferent, formatPtr(job1.Completions), formatPtr(job2.Completions))
+	}
+
+	if getJobCompletionMode(job1) != getJobCompletionMode(job2) {
+		return fmt.Errorf("%w. Job 1 - %s, Job 2 - %s", ErrorCompletionModeDifferent, getJobCompletionMode(job1), getJobCompletionMode(job2))
+	}
+
+	if !areInt32PtrsEqual(job1.BackoffLimit, job2.BackoffLimit) {
+		return fmt.Errorf("%w. Job 1 - %s, Job 2 - %s", ErrorBackoffLimitDifferent, formatPtr(job1.BackoffLimit), formatPtr(job2.BackoffLimit))
+	}
+
+	if !areInt64PtrsEqual(job1.ActiveDeadlineSeconds, job2.ActiveDeadlineSeconds) {
+		return fmt.Errorf("%w. Job 1 - %s, Job 2 - %s", ErrorActiveDeadlineSecondsDifferent, formatPtr(job1.ActiveDeadlineSeconds), formatPtr(job2.ActiveDeadlineSeconds))
+	}
+
+	if !areInt32PtrsEqual(job1.TTLSecondsAfterFinished, job2.TTLSecondsAfterFinished) {
+		return fmt.Errorf("%w. Job 1 - %s, Job 2 - %s", ErrorTTLSecondsAfterFinishedDifferent, formatPtr(job1.TTLSecondsAfterFinished), formatPtr(job2.TTLSecondsAfterFinished))
 	}
 
 	if job1.Template.Spec.RestartPolicy != job2.Template.Spec.RestartPolicy {
@@ -0,0 +1,112 @@
+package jobs
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+	"k8s-cluster-comparator/internal/kubernetes/skipper"
+	"k8s-cluster-comparator/internal/kubernetes/types"
+)
+
+const (
+	// JobMatchByName pairs jobs by their names, jobs created with generateName are paired by the name prefix
+	JobMatchByName = "name"
+	// JobMatchByLabels pairs jobs by their label sets, jobs without labels are paired by name
+	JobMatchByLabels = "labels"
+)
+
+var (
+	// jobControllerLabels are set by the job controller and differ for the same job in different clusters
+	jobControllerLabels = map[string]struct{}{
+		"controller-uid":                     {},
+		"job-name":                           {},
+		"batch.kubernetes.io/controller-uid": {},
+		"batch.kubernetes.io/job-name":       {},
+	}
+
+	// jobControllerAnnotations are set by the job controller
+	jobControllerAnnotations = map[string]struct{}{
+		"batch.kubernetes.io/job-tracking": {},
+	}
+
+	// jobSkippedLabels are labels skipped from comparison of jobs metadata
+	jobSkippedLabels = mergeSkippedKeys(common.SkippedKubeLabels, jobControllerLabels)
+)
+
+// getJobMatchKey returns the key pairing the job with a job of the other cluster.
+// Runs of a cronJob are keyed by their owner since only the latest successful run is compared
+func getJobMatchKey(j *job) (key string, isCronJobRun bool) {
+	for _, owner := range j.OwnerReferences {
+		if owner.Kind == "CronJob" {
+			return fmt.Sprintf("%s (latest successful run of cronJob)", owner.Name), true
+		}
+	}
+
+	if jobMatchStrategy == JobMatchByLabels {
+		jobLabels := make(labels.Set, len(j.Labels))
+		for key, value := range j.Labels {
+			if _, ok := jobSkippedLabels[key]; !ok {
+				jobLabels[key] = value
+			}
+		}
+
+		if len(jobLabels) > 0 {
+			return fmt.Sprintf("{%s}", jobLabels.String()), false
+		}
+	}
+
+	if j.GenerateName != "" {
+		return j.GenerateName + "*", false
+	}
+
+	return j.Name, false
+}
+
+// prepareJobsMap pairs keys of jobs with their indexes. When several jobs share a key the latest one is kept,
+// runs of cronJobs which have not succeeded are skipped
+func prepareJobsMap(jobs []job, skipEntities skipper.SkipComponentNames) map[string]types.IsAlreadyComparedFlag {
+	mapJobs := make(map[string]types.IsAlreadyComparedFlag)
+
+	for index := range jobs {
+		value := &jobs[index]
+
+		if skipEntities.IsSkippedEntity(value.Name) {
+			log.Debugf("job %s is skipped from comparison due to its name", value.Name)
+			continue
+		}
+
+		key, isCronJobRun := getJobMatchKey(value)
+
+		if isCronJobRun && getJobOutcome(value.Status) != jobOutcomeSucceeded {
+			log.Debugf("job %s is skipped from comparison as an unsuccessful run of a cronJob", value.Name)
+			continue
+		}
+
+		if indexCheck, ok := mapJobs[key]; ok {
+			if !jobs[indexCheck.Index].CreationTimestamp.Before(&value.CreationTimestamp) {
+				log.Debugf("job %s is skipped from comparison in favor of the later job %s", value.Name, jobs[indexCheck.Index].Name)
+				continue
+			}
+			log.Debugf("job %s is skipped from comparison in favor of the later job %s", jobs[indexCheck.Index].Name, value.Name)
+		}
+
+		mapJobs[key] = types.IsAlreadyComparedFlag{Index: index}
+	}
+
+	return mapJobs
+}
+
+// mergeSkippedKeys returns a union of key sets
+func mergeSkippedKeys(sets ...map[string]struct{}) map[string]struct{} {
+	merged := make(map[string]struct{})
+
+	for _, set := range sets {
+		for key := range set {
+			merged[key] = struct{}{}
+		}
+	}
+
+	return merged
+}
@@ -0,0 +1,81 @@
+package jobs
+
+import (
+	"fmt"
+
+	v12 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+)
+
+const (
+	jobGroupVersionBatchV1 = "batch/v1"
+
+	// defaultJobCompletionMode is assumed for jobs of clusters without completion mode support
+	defaultJobCompletionMode = "NonIndexed"
+
+	jobOutcomeSucceeded = "succeeded"
+	jobOutcomeFailed    = "failed"
+	jobOutcomeRunning   = "not finished"
+)
+
+// jobList mirrors batch/v1 JobList
+type jobList struct {
+	Items []job `json:"items"`
+}
+
+// job mirrors a batch/v1 Job
+type job struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   jobSpec       `json:"spec,omitempty"`
+	Status v12.JobStatus `json:"status,omitempty"`
+}
+
+// jobSpec mirrors a batch/v1 Job spec extended with fields unknown to the vendored client-go
+type jobSpec struct {
+	v12.JobSpec
+
+	CompletionMode *string `json:"completionMode,omitempty"`
+}
+
+// getJobs returns batch/v1 jobs of the namespace
+func getJobs(clientSet kubernetes.Interface, namespace string) ([]job, error) {
+	list := jobList{}
+
+	if err := common.GetRawResourceList(clientSet, jobGroupVersionBatchV1, namespace, "jobs", &list); err != nil {
+		return nil, fmt.Errorf("cannot obtain jobs using '%s' API: %w", jobGroupVersionBatchV1, err)
+	}
+
+	return list.Items, nil
+}
+
+// getJobCompletionMode returns the completion mode taking into account clusters without its support
+func getJobCompletionMode(spec jobSpec) string {
+	if spec.CompletionMode == nil {
+		return defaultJobCompletionMode
+	}
+
+	return *spec.CompletionMode
+}
+
+// getJobOutcome returns whether the job has succeeded, failed or is not finished yet
+func getJobOutcome(status v12.JobStatus) string {
+	for _, condition := range status.Conditions {
+		if condition.Status != v1.ConditionTrue {
+			continue
+		}
+
+		switch condition.Type {
+		case v12.JobComplete:
+			return jobOutcomeSucceeded
+		case v12.JobFailed:
+			return jobOutcomeFailed
+		}
+	}
+
+	return jobOutcomeRunning
+}
@@ -0,0 +1,156 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	v12 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"k8s-cluster-comparator/internal/kubernetes/pod_controllers"
+	"k8s-cluster-comparator/internal/logging"
+)
+
+func TestCompareSpecInJobs(t *testing.T) {
+	if err := logging.Configure(false); err != nil {
+		t.Fatalf("cannot configure logging: %s", err.Error())
+	}
+	if err := pod_controllers.Init(context.Background()); err != nil {
+		t.Fatalf("cannot init pod_controllers package: %s", err)
+	}
+
+	newJobSpec := func() jobSpec {
+		var (
+			parallelism  = int32(2)
+			completions  = int32(4)
+			backoffLimit = int32(6)
+		)
+
+		return jobSpec{
+			JobSpec: v12.JobSpec{
+				Parallelism:  &parallelism,
+				Completions:  &completions,
+				BackoffLimit: &backoffLimit,
+			},
+		}
+	}
+
+	job1, job2 := newJobSpec(), newJobSpec()
+	if err := compareSpecInJobs(job1, job2, "default"); err != nil {
+		t.Error("Equal jobs are reported as different: ", err)
+	}
+
+	backoffLimit := int32(1)
+	job2.BackoffLimit = &backoffLimit
+	if err := compareSpecInJobs(job1, job2, "default"); !errors.Is(err, ErrorBackoffLimitDifferent) {
+		t.Error("Error expected: 'backoffLimit in jobs is different'. But it was returned: ", err)
+	} else if err.Error() != "backoffLimit in jobs is different. Job 1 - 6, Job 2 - 1" {
+		t.Error("Unexpected error message: ", err)
+	}
+
+	job2 = newJobSpec()
+	job2.Parallelism = nil
+	if err := compareSpecInJobs(job1, job2, "default"); !errors.Is(err, ErrorParallelismDifferent) {
+		t.Error("Error expected: 'parallelism in jobs is different'. But it was returned: ", err)
+	}
+
+	job2 = newJobSpec()
+	completionMode := defaultJobCompletionMode
+	job2.CompletionMode = &completionMode
+	if err := compareSpecInJobs(job1, job2, "default"); err != nil {
+		t.Error("Default completion mode is reported as different from an unset one: ", err)
+	}
+
+	completionMode = "Indexed"
+	if err := compareSpecInJobs(job1, job2, "default"); !errors.Is(err, ErrorCompletionModeDifferent) {
+		t.Error("Error expected: 'completionMode in jobs is different'. But it was returned: ", err)
+	}
+
+	job2 = newJobSpec()
+	ttl := int32(3600)
+	job2.TTLSecondsAfterFinished = &ttl
+	if err := compareSpecInJobs(job1, job2, "default"); !errors.Is(err, ErrorTTLSecondsAfterFinishedDifferent) {
+		t.Error("Error expected: 'ttlSecondsAfterFinished in jobs is different'. But it was returned: ", err)
+	}
+}
+
+func TestGetJobOutcome(t *testing.T) {
+	testCases := []struct {
+		conditions []v12.JobCondition
+		outcome    string
+	}{
+		{nil, jobOutcomeRunning},
+		{[]v12.JobCondition{{Type: v12.JobComplete, Status: v1.ConditionTrue}}, jobOutcomeSucceeded},
+		{[]v12.JobCondition{{Type: v12.JobFailed, Status: v1.ConditionTrue}}, jobOutcomeFailed},
+		{[]v12.JobCondition{{Type: v12.JobFailed, Status: v1.ConditionFalse}}, jobOutcomeRunning},
+	}
+
+	for _, tc := range testCases {
+		if outcome := getJobOutcome(v12.JobStatus{Conditions: tc.conditions}); outcome != tc.outcome {
+			t.Errorf("expected outcome '%s', got '%s' for conditions %v", tc.outcome, outcome, tc.conditions)
+		}
+	}
+}
+
+func TestPrepareJobsMap(t *testing.T) {
+	if err := logging.Configure(false); err != nil {
+		t.Fatalf("cannot configure logging: %s", err.Error())
+	}
+	if err := Init(context.Background()); err != nil {
+		t.Fatalf("cannot init jobs package: %s", err)
+	}
+
+	started := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newJob := func(name, generateName, owner string, age time.Duration, outcome v12.JobConditionType) job {
+		j := job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				GenerateName:      generateName,
+				CreationTimestamp: metav1.NewTime(started.Add(-age)),
+				Labels:            map[string]string{"app": "migrate", "controller-uid": name},
+			},
+		}
+
+		if owner != "" {
+			j.OwnerReferences = []metav1.OwnerReference{{Kind: "CronJob", Name: owner}}
+		}
+		if outcome != "" {
+			j.Status.Conditions = []v12.JobCondition{{Type: outcome, Status: v1.ConditionTrue}}
+		}
+
+		return j
+	}
+
+	jobs := []job{
+		newJob("backup-1000", "", "backup", 3*time.Hour, v12.JobComplete),
+		newJob("backup-1060", "", "backup", 2*time.Hour, v12.JobComplete),
+		newJob("backup-1120", "", "backup", time.Hour, v12.JobFailed),
+		newJob("migrate-x7k2p", "migrate-", "", time.Hour, v12.JobComplete),
+		newJob("seed", "", "", 2*time.Hour, ""),
+	}
+
+	mapJobs := prepareJobsMap(jobs, nil)
+	if len(mapJobs) != 3 {
+		t.Errorf("expected 3 jobs to compare, got %d: %v", len(mapJobs), mapJobs)
+	}
+	if index, ok := mapJobs["backup (latest successful run of cronJob)"]; !ok || jobs[index.Index].Name != "backup-1060" {
+		t.Errorf("the latest successful run of cronJob is not selected: %v", mapJobs)
+	}
+	if _, ok := mapJobs["migrate-*"]; !ok {
+		t.Errorf("the job created with generateName is not keyed by its name prefix: %v", mapJobs)
+	}
+
+	jobMatchStrategy = JobMatchByLabels
+	defer func() {
+		jobMatchStrategy = JobMatchByName
+	}()
+
+	mapJobs = prepareJobsMap(jobs, nil)
+	if index, ok := mapJobs["{app=migrate}"]; !ok || jobs[index.Index].Name != "migrate-x7k2p" {
+		t.Errorf("the latest job with the label set is not selected: %v", mapJobs)
+	}
+}
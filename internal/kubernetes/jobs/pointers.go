@@ -0,0 +1,40 @@
+package jobs
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// areInt32PtrsEqual reports whether both values are unset or set to the same number
+func areInt32PtrsEqual(value1, value2 *int32) bool {
+	if value1 != nil && value2 != nil {
+		return *value1 == *value2
+	}
+	return value1 == nil && value2 == nil
+}
+
+// areInt64PtrsEqual reports whether both values are unset or set to the same number
+func areInt64PtrsEqual(value1, value2 *int64) bool {
+	if value1 != nil && value2 != nil {
+		return *value1 == *value2
+	}
+	return value1 == nil && value2 == nil
+}
+
+// areBoolPtrsEqual reports whether both values are unset or set to the same value
+func areBoolPtrsEqual(value1, value2 *bool) bool {
+	if value1 != nil && value2 != nil {
+		return *value1 == *value2
+	}
+	return value1 == nil && value2 == nil
+}
+
+// formatPtr returns the value a pointer refers to or 'unset' for a nil pointer
+func formatPtr(value interface{}) string {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return "unset"
+	}
+
+	return fmt.Sprint(v.Elem().Interface())
+}
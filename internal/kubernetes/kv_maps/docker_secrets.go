@@ -0,0 +1,145 @@
+package kv_maps
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	v12 "k8s.io/api/core/v1"
+)
+
+var (
+	ignoreRegistryTokens bool
+)
+
+// dockerConfigEntry is a credentials entry for a single registry in .dockerconfigjson/.dockercfg
+type dockerConfigEntry struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Auth     string `json:"auth,omitempty"`
+}
+
+// dockerConfigJSON is the content of .dockerconfigjson key
+type dockerConfigJSON struct {
+	Auths map[string]dockerConfigEntry `json:"auths"`
+}
+
+// registryCredentials contains the credentials of a registry in a form suitable for comparison
+type registryCredentials struct {
+	Username string
+	Token    []byte
+}
+
+// normalizeRegistryName strips the scheme and trailing slashes so that different spellings of the same registry match
+func normalizeRegistryName(registry string) string {
+	registry = strings.TrimPrefix(registry, "https://")
+	registry = strings.TrimPrefix(registry, "http://")
+
+	return strings.TrimRight(registry, "/")
+}
+
+// parseDockerConfigSecret extracts credentials per registry from a kubernetes.io/dockerconfigjson or kubernetes.io/dockercfg secret
+func parseDockerConfigSecret(secret *v12.Secret) (map[string]registryCredentials, error) {
+	var (
+		entries map[string]dockerConfigEntry
+	)
+
+	if secret.Type == v12.SecretTypeDockerConfigJson {
+		cfg := dockerConfigJSON{}
+
+		if err := json.Unmarshal(secret.Data[v12.DockerConfigJsonKey], &cfg); err != nil {
+			return nil, err
+		}
+
+		entries = cfg.Auths
+	} else {
+		if err := json.Unmarshal(secret.Data[v12.DockerConfigKey], &entries); err != nil {
+			return nil, err
+		}
+	}
+
+	credentials := make(map[string]registryCredentials, len(entries))
+
+	for registry, entry := range entries {
+		username := entry.Username
+		token := entry.Password
+
+		if entry.Auth != "" {
+			if decoded, err := base64.StdEncoding.DecodeString(entry.Auth); err == nil {
+				parts := strings.SplitN(string(decoded), ":", 2)
+				if username == "" {
+					username = parts[0]
+				}
+				if token == "" && len(parts) > 1 {
+					token = parts[1]
+				}
+			}
+		}
+
+		credentials[normalizeRegistryName(registry)] = registryCredentials{
+			Username: username,
+			Token:    []byte(token),
+		}
+	}
+
+	return credentials, nil
+}
+
+// compareDockerConfigSecrets compares registry credential secrets by the set of registries and their usernames
+func compareDockerConfigSecrets(name string, secret1, secret2 *v12.Secret) (map[string]struct{}, bool) {
+	var (
+		flag bool
+	)
+
+	credentials1, err := parseDockerConfigSecret(secret1)
+	if err != nil {
+		log.Infof("secret '%s': cannot parse registry credentials in 1st cluster: %s", name, err.Error())
+		return nil, true
+	}
+
+	credentials2, err := parseDockerConfigSecret(secret2)
+	if err != nil {
+		log.Infof("secret '%s': cannot parse registry credentials in 2nd cluster: %s", name, err.Error())
+		return nil, true
+	}
+
+	registries := make([]string, 0, len(credentials1))
+	for registry := range credentials1 {
+		registries = append(registries, registry)
+	}
+	sort.Strings(registries)
+
+	for _, registry := range registries {
+		creds1 := credentials1[registry]
+
+		creds2, ok := credentials2[registry]
+		if !ok {
+			log.Infof("secret '%s': credentials for registry '%s' are missing in 2nd cluster", name, registry)
+			flag = true
+			continue
+		}
+
+		if creds1.Username != creds2.Username {
+			log.Infof("secret '%s': usernames for registry '%s' are different: '%s' and '%s'", name, registry, creds1.Username, creds2.Username)
+			flag = true
+		}
+
+		if !ignoreRegistryTokens && !AreSecretValuesEqual(creds1.Token, creds2.Token) {
+			log.Infof("secret '%s': tokens for registry '%s' do not match: %s", name, registry, DescribeSecretValuesDiff(creds1.Token, creds2.Token))
+			flag = true
+		}
+	}
+
+	for registry := range credentials2 {
+		if _, ok := credentials1[registry]; !ok {
+			log.Infof("secret '%s': credentials for registry '%s' are missing in 1st cluster", name, registry)
+			flag = true
+		}
+	}
+
+	return map[string]struct{}{
+		v12.DockerConfigJsonKey: {},
+		v12.DockerConfigKey:     {},
+	}, flag
+}
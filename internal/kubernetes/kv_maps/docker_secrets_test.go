@@ -0,0 +1,55 @@
+package kv_maps
+
+import (
+	"context"
+	"testing"
+
+	v12 "k8s.io/api/core/v1"
+
+	"k8s-cluster-comparator/internal/logging"
+)
+
+func TestCompareDockerConfigSecrets(t *testing.T) {
+	if err := logging.Configure(false); err != nil {
+		t.Fatalf("cannot configure logging: %s", err.Error())
+	}
+	if err := Init(context.Background()); err != nil {
+		t.Fatalf("cannot init kv_maps package: %s", err.Error())
+	}
+
+	secret1 := &v12.Secret{
+		Type: v12.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			v12.DockerConfigJsonKey: []byte(`{"auths":{"https://registry.example.com/":{"username":"robot","password":"token1"}}}`),
+		},
+	}
+	secret2 := &v12.Secret{
+		Type: v12.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			// "robot:token2" encoded in base64
+			v12.DockerConfigJsonKey: []byte(`{"auths":{"registry.example.com":{"auth":"cm9ib3Q6dG9rZW4y"}}}`),
+		},
+	}
+
+	_, isDiffer := compareDockerConfigSecrets("registry", secret1, secret2)
+	if !isDiffer {
+		t.Error("different registry tokens are reported as equal")
+	}
+
+	ignoreRegistryTokens = true
+	defer func() {
+		ignoreRegistryTokens = false
+	}()
+
+	_, isDiffer = compareDockerConfigSecrets("registry", secret1, secret2)
+	if isDiffer {
+		t.Error("credentials for the same registry and username are reported as different although tokens are ignored")
+	}
+
+	secret2.Data[v12.DockerConfigJsonKey] = []byte(`{"auths":{"other.example.com":{"username":"robot","password":"token1"}}}`)
+
+	_, isDiffer = compareDockerConfigSecrets("registry", secret1, secret2)
+	if !isDiffer {
+		t.Error("missing registry credentials are not reported")
+	}
+}
@@ -5,14 +5,25 @@ import (
 
 	"go.uber.org/zap"
 
+	"k8s-cluster-comparator/internal/config"
 	"k8s-cluster-comparator/internal/logging"
 )
 
 var (
 	log *zap.SugaredLogger
+
+	revealSecrets bool
 )
 
 func Init(ctx context.Context) error {
 	log = logging.FromContext(ctx)
+
+	cfg := config.FromContext(ctx)
+
+	revealSecrets = cfg.RevealSecrets
+	tlsExpiryThreshold = cfg.TLSExpiryThreshold
+	ignoreRegistryTokens = cfg.IgnoreRegistryTokens
+	secretsFilterConfig = newSecretsFilter(cfg.SecretTypesInclude, cfg.SecretTypesExclude, cfg.SecretSkipSelector)
+
 	return nil
 }
@@ -0,0 +1,53 @@
+package kv_maps
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"sync"
+)
+
+const (
+	secretHashKeyLength = 32
+)
+
+var (
+	secretHashKey     []byte
+	secretHashKeyOnce sync.Once
+)
+
+// getSecretHashKey returns a random key generated once per run, so secret hashes cannot be correlated between runs
+func getSecretHashKey() []byte {
+	secretHashKeyOnce.Do(func() {
+		secretHashKey = make([]byte, secretHashKeyLength)
+
+		if _, err := rand.Read(secretHashKey); err != nil {
+			panic(fmt.Sprintf("cannot generate secret hash key: %s", err.Error()))
+		}
+	})
+
+	return secretHashKey
+}
+
+// hashSecretValue calculates a keyed hash (HMAC-SHA256) of a secret value
+func hashSecretValue(value []byte) []byte {
+	mac := hmac.New(sha256.New, getSecretHashKey())
+	mac.Write(value) //nolint:errcheck
+
+	return mac.Sum(nil)
+}
+
+// AreSecretValuesEqual compares two secret values by their keyed hashes without keeping the plain values around
+func AreSecretValuesEqual(value1, value2 []byte) bool {
+	return hmac.Equal(hashSecretValue(value1), hashSecretValue(value2))
+}
+
+// DescribeSecretValuesDiff returns a description of two differing secret values which is safe to log. Values are revealed only if it was explicitly requested
+func DescribeSecretValuesDiff(value1, value2 []byte) string {
+	if revealSecrets {
+		return fmt.Sprintf("'%s' and '%s'", string(value1), string(value2))
+	}
+
+	return fmt.Sprintf("values differ (lengths %d and %d)", len(value1), len(value2))
+}
@@ -0,0 +1,42 @@
+package kv_maps
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAreSecretValuesEqual(t *testing.T) {
+	if !AreSecretValuesEqual([]byte("password"), []byte("password")) {
+		t.Error("equal secret values are reported as different")
+	}
+
+	if AreSecretValuesEqual([]byte("password1"), []byte("password2")) {
+		t.Error("different secret values are reported as equal")
+	}
+
+	if !AreSecretValuesEqual(nil, []byte{}) {
+		t.Error("empty secret values are reported as different")
+	}
+}
+
+func TestDescribeSecretValuesDiff(t *testing.T) {
+	revealSecrets = false
+
+	description := DescribeSecretValuesDiff([]byte("password1"), []byte("pass2"))
+	if strings.Contains(description, "password1") || strings.Contains(description, "pass2") {
+		t.Errorf("secret values are revealed in the description: %s", description)
+	}
+	if !strings.Contains(description, "9") || !strings.Contains(description, "5") {
+		t.Errorf("lengths of secret values are absent in the description: %s", description)
+	}
+
+	revealSecrets = true
+	defer func() {
+		revealSecrets = false
+	}()
+
+	description = DescribeSecretValuesDiff([]byte("password1"), []byte("pass2"))
+	if !strings.Contains(description, "password1") || !strings.Contains(description, "pass2") {
+		t.Errorf("secret values are not revealed in the description although it was requested: %s", description)
+	}
+}
@@ -3,6 +3,7 @@ package kv_maps
 import (
 	v12 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 
 	"k8s-cluster-comparator/internal/kubernetes/common"
@@ -10,17 +11,76 @@ import (
 	"k8s-cluster-comparator/internal/kubernetes/types"
 
 	"fmt"
+	"sort"
 	"sync"
 )
 
+const (
+	skipReasonLabels = "label selector"
+)
+
+// secretsFilter decides which secrets take part in the comparison
+type secretsFilter struct {
+	includeTypes map[v12.SecretType]struct{}
+	excludeTypes map[v12.SecretType]struct{}
+
+	skipSelector labels.Selector
+}
+
+// secretDataCompareFunc compares data of secrets of a specific type. It returns the set of keys it has compared and whether the data differs
+type secretDataCompareFunc func(name string, secret1, secret2 *v12.Secret) (map[string]struct{}, bool)
+
 var (
-	skipSecretTypes = [3]v12.SecretType{
-		"kubernetes.io/service-account-token",
-		"kubernetes.io/dockercfg",
-		"helm.sh/release.v1",
+	secretsFilterConfig secretsFilter
+
+	// secretDataComparers contains semantic comparers of secret data for well-known secret types
+	secretDataComparers = map[v12.SecretType]secretDataCompareFunc{
+		v12.SecretTypeTLS:              compareTLSSecrets,
+		v12.SecretTypeDockerConfigJson: compareDockerConfigSecrets,
+		v12.SecretTypeDockercfg:        compareDockerConfigSecrets,
 	}
 )
 
+// newSecretsFilter builds a secrets filter from the lists of included and excluded secret types and a label selector
+func newSecretsFilter(includeTypes, excludeTypes []string, skipSelector labels.Selector) secretsFilter {
+	filter := secretsFilter{
+		skipSelector: skipSelector,
+	}
+
+	if len(includeTypes) > 0 {
+		filter.includeTypes = make(map[v12.SecretType]struct{}, len(includeTypes))
+		for _, secretType := range includeTypes {
+			filter.includeTypes[v12.SecretType(secretType)] = struct{}{}
+		}
+	}
+
+	filter.excludeTypes = make(map[v12.SecretType]struct{}, len(excludeTypes))
+	for _, secretType := range excludeTypes {
+		filter.excludeTypes[v12.SecretType(secretType)] = struct{}{}
+	}
+
+	return filter
+}
+
+// skipReason returns the reason why the secret must be skipped from comparison or an empty string if it must be compared
+func (f secretsFilter) skipReason(secret *v12.Secret) string {
+	if f.includeTypes != nil {
+		if _, ok := f.includeTypes[secret.Type]; !ok {
+			return string(secret.Type)
+		}
+	}
+
+	if _, ok := f.excludeTypes[secret.Type]; ok {
+		return string(secret.Type)
+	}
+
+	if f.skipSelector != nil && !f.skipSelector.Empty() && f.skipSelector.Matches(labels.Set(secret.Labels)) {
+		return skipReasonLabels
+	}
+
+	return ""
+}
+
 // CompareSecrets compares list of secret objects in two given k8s-clusters
 func CompareSecrets(clientSet1, clientSet2 kubernetes.Interface, namespace string, skipEntityList skipper.SkipEntitiesList) (bool, error) {
 	var (
@@ -47,11 +107,14 @@ func CompareSecrets(clientSet1, clientSet2 kubernetes.Interface, namespace strin
 func prepareSecretMaps(secrets1, secrets2 *v12.SecretList, skipEntities skipper.SkipComponentNames) (map[string]types.IsAlreadyComparedFlag, map[string]types.IsAlreadyComparedFlag) { //nolint:gocritic,unused
 	mapSecrets1 := make(map[string]types.IsAlreadyComparedFlag)
 	mapSecrets2 := make(map[string]types.IsAlreadyComparedFlag)
+	skipped1 := make(map[string]int)
+	skipped2 := make(map[string]int)
 	var indexCheck types.IsAlreadyComparedFlag
 
 	for index, value := range secrets1.Items {
-		if checkContinueTypes(value.Type) {
-			log.Debugf("secret %s is skipped from comparison due to its '%s' type", value.Name, value.Type)
+		if reason := secretsFilterConfig.skipReason(&secrets1.Items[index]); reason != "" {
+			log.Debugf("secret %s is skipped from comparison due to its '%s' type or labels", value.Name, value.Type)
+			skipped1[reason]++
 			continue
 		}
 		if skipEntities.IsSkippedEntity(value.Name) {
@@ -63,8 +126,9 @@ func prepareSecretMaps(secrets1, secrets2 *v12.SecretList, skipEntities skipper.
 
 	}
 	for index, value := range secrets2.Items {
-		if checkContinueTypes(value.Type) {
-			log.Debugf("secret %s is skipped from comparison due to its '%s' type", value.Name, value.Type)
+		if reason := secretsFilterConfig.skipReason(&secrets2.Items[index]); reason != "" {
+			log.Debugf("secret %s is skipped from comparison due to its '%s' type or labels", value.Name, value.Type)
+			skipped2[reason]++
 			continue
 		}
 		if skipEntities.IsSkippedEntity(value.Name) {
@@ -75,9 +139,27 @@ func prepareSecretMaps(secrets1, secrets2 *v12.SecretList, skipEntities skipper.
 		mapSecrets2[value.Name] = indexCheck
 
 	}
+
+	logSkippedSecrets(skipped1, "1st")
+	logSkippedSecrets(skipped2, "2nd")
+
 	return mapSecrets1, mapSecrets2
 }
 
+// logSkippedSecrets reports how many secrets were skipped per secret type
+func logSkippedSecrets(skipped map[string]int, clusterName string) {
+	reasons := make([]string, 0, len(skipped))
+	for reason := range skipped {
+		reasons = append(reasons, reason)
+	}
+
+	sort.Strings(reasons)
+
+	for _, reason := range reasons {
+		log.Infof("%d secret(s) skipped in %s cluster: %s", skipped[reason], clusterName, reason)
+	}
+}
+
 func compareSecretSpecInternals(wg *sync.WaitGroup, channel chan bool, name string, secret1, secret2 *v12.Secret) {
 	var (
 		flag bool
@@ -101,26 +183,75 @@ func compareSecretSpecInternals(wg *sync.WaitGroup, channel chan bool, name stri
 		return
 	}
 
-	if len(secret1.Data) != len(secret2.Data) {
-		log.Infof("secret '%s' in 1st cluster has '%d' keys but the 2nd - '%d'", name, len(secret1.Data), len(secret2.Data))
-		flag = true
-	} else {
-		for key, value := range secret1.Data {
-			v1 := string(value)
-			v2 := string(secret2.Data[key])
-
-			if v1 != v2 {
-				log.Infof("secret '%s', values by key '%s' do not match: '%s' and %s", name, key, v1, v2)
-				flag = true
-			}
+	if secret1.Type != secret2.Type {
+		log.Infof("secret '%s' has different types: '%s' and '%s'", name, secret1.Type, secret2.Type)
+		channel <- true
+		return
+	}
+
+	var comparedKeys map[string]struct{}
+
+	if compareFn, ok := secretDataComparers[secret1.Type]; ok {
+		var isDiffer bool
+
+		comparedKeys, isDiffer = compareFn(name, secret1, secret2)
+		if isDiffer {
+			flag = true
 		}
 	}
 
+	if compareSecretData(name, secret1.Data, secret2.Data, comparedKeys) {
+		flag = true
+	}
+
 	log.Debugf("----- End checking secret: '%s' -----", name)
 
 	channel <- flag
 }
 
+// compareSecretData compares secret values key by key, keys from the skipKeys set are expected to be compared already
+func compareSecretData(name string, data1, data2 map[string][]byte, skipKeys map[string]struct{}) bool {
+	var (
+		flag bool
+	)
+
+	if len(data1) != len(data2) {
+		log.Infof("secret '%s' in 1st cluster has '%d' keys but the 2nd - '%d'", name, len(data1), len(data2))
+		flag = true
+	}
+
+	for key, value1 := range data1 {
+		if _, ok := skipKeys[key]; ok {
+			continue
+		}
+
+		value2, ok := data2[key]
+		if !ok {
+			log.Infof("secret '%s', key '%s' does not exist in 2nd cluster", name, key)
+			flag = true
+			continue
+		}
+
+		if !AreSecretValuesEqual(value1, value2) {
+			log.Infof("secret '%s', values by key '%s' do not match: %s", name, key, DescribeSecretValuesDiff(value1, value2))
+			flag = true
+		}
+	}
+
+	for key := range data2 {
+		if _, ok := skipKeys[key]; ok {
+			continue
+		}
+
+		if _, ok := data1[key]; !ok {
+			log.Infof("secret '%s', key '%s' does not exist in 1st cluster", name, key)
+			flag = true
+		}
+	}
+
+	return flag
+}
+
 // compareSecretsSpecs set information about secrets
 func compareSecretsSpecs(map1, map2 map[string]types.IsAlreadyComparedFlag, secrets1, secrets2 *v12.SecretList) bool {
 	var (
@@ -170,13 +301,3 @@ func compareSecretsSpecs(map1, map2 map[string]types.IsAlreadyComparedFlag, secr
 	}
 	return flag
 }
-
-func checkContinueTypes(secretType v12.SecretType) bool {
-	var skip bool
-	for _, skipType := range skipSecretTypes {
-		if secretType == skipType {
-			skip = true
-		}
-	}
-	return skip
-}
@@ -0,0 +1,44 @@
+package kv_maps
+
+import (
+	"testing"
+
+	v12 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestSecretsFilterSkipReason(t *testing.T) {
+	var (
+		opaqueSecret = &v12.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "opaque"},
+			Type:       v12.SecretTypeOpaque,
+		}
+		tokenSecret = &v12.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "token"},
+			Type:       v12.SecretTypeServiceAccountToken,
+		}
+		helmSecret = &v12.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "helm", Labels: map[string]string{"owner": "helm"}},
+			Type:       v12.SecretTypeOpaque,
+		}
+	)
+
+	filter := newSecretsFilter(nil, []string{string(v12.SecretTypeServiceAccountToken)}, labels.SelectorFromSet(labels.Set{"owner": "helm"}))
+
+	if reason := filter.skipReason(opaqueSecret); reason != "" {
+		t.Errorf("opaque secret must not be skipped, but it was skipped due to '%s'", reason)
+	}
+	if reason := filter.skipReason(tokenSecret); reason != string(v12.SecretTypeServiceAccountToken) {
+		t.Errorf("service account token secret must be skipped due to its type, but the reason is '%s'", reason)
+	}
+	if reason := filter.skipReason(helmSecret); reason != skipReasonLabels {
+		t.Errorf("helm secret must be skipped due to its labels, but the reason is '%s'", reason)
+	}
+
+	filter = newSecretsFilter([]string{string(v12.SecretTypeTLS)}, nil, nil)
+
+	if reason := filter.skipReason(opaqueSecret); reason != string(v12.SecretTypeOpaque) {
+		t.Errorf("opaque secret must be skipped as its type is not included, but the reason is '%s'", reason)
+	}
+}
@@ -0,0 +1,163 @@
+package kv_maps
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v12 "k8s.io/api/core/v1"
+)
+
+var (
+	tlsExpiryThreshold time.Duration
+
+	errNoCertificatePEM = errors.New("no PEM encoded certificate found")
+)
+
+// tlsCertificateInfo contains the certificate attributes that are expected to be equal in both clusters even if the certificate was issued per cluster
+type tlsCertificateInfo struct {
+	Subject      string
+	Issuer       string
+	SANs         []string
+	KeyAlgorithm string
+	Lifetime     time.Duration
+
+	NotAfter   time.Time
+	KeyMatches bool
+}
+
+// parseTLSSecret extracts the leaf certificate attributes from a kubernetes.io/tls secret and checks that its private key matches the certificate
+func parseTLSSecret(secret *v12.Secret) (*tlsCertificateInfo, error) {
+	certPEM := secret.Data[v12.TLSCertKey]
+
+	block, _ := pem.Decode(certPEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errNoCertificatePEM
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	sans := make([]string, 0, len(cert.DNSNames)+len(cert.IPAddresses)+len(cert.EmailAddresses)+len(cert.URIs))
+	sans = append(sans, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+	sans = append(sans, cert.EmailAddresses...)
+	for _, uri := range cert.URIs {
+		sans = append(sans, uri.String())
+	}
+	sort.Strings(sans)
+
+	_, err = tls.X509KeyPair(certPEM, secret.Data[v12.TLSPrivateKeyKey])
+
+	return &tlsCertificateInfo{
+		Subject:      cert.Subject.String(),
+		Issuer:       cert.Issuer.String(),
+		SANs:         sans,
+		KeyAlgorithm: describePublicKey(cert.PublicKey),
+		Lifetime:     cert.NotAfter.Sub(cert.NotBefore).Truncate(time.Hour),
+		NotAfter:     cert.NotAfter,
+		KeyMatches:   err == nil,
+	}, nil
+}
+
+// describePublicKey returns the algorithm and the size of a public key
+func describePublicKey(publicKey interface{}) string {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		return fmt.Sprintf("RSA-%d", key.N.BitLen())
+	case *ecdsa.PublicKey:
+		return fmt.Sprintf("ECDSA-%s", key.Curve.Params().Name)
+	case ed25519.PublicKey:
+		return "Ed25519"
+	default:
+		return fmt.Sprintf("%T", publicKey)
+	}
+}
+
+// checkTLSCertificateHealth warns about certificates that expire soon or whose private key does not match
+func checkTLSCertificateHealth(name, clusterName string, info *tlsCertificateInfo) {
+	if !info.KeyMatches {
+		log.Warnf("secret '%s' in %s cluster: private key does not match the certificate", name, clusterName)
+	}
+
+	expiresIn := time.Until(info.NotAfter)
+
+	if expiresIn <= 0 {
+		log.Warnf("secret '%s' in %s cluster: certificate expired at %s", name, clusterName, info.NotAfter.Format(time.RFC3339))
+	} else if tlsExpiryThreshold > 0 && expiresIn < tlsExpiryThreshold {
+		log.Warnf("secret '%s' in %s cluster: certificate expires soon, at %s", name, clusterName, info.NotAfter.Format(time.RFC3339))
+	}
+}
+
+// compareTLSSecrets compares certificates of kubernetes.io/tls secrets semantically instead of byte by byte.
+// When a certificate cannot be parsed no keys are reported as compared, so the data is compared as is
+func compareTLSSecrets(name string, secret1, secret2 *v12.Secret) (map[string]struct{}, bool) {
+	var (
+		flag bool
+	)
+
+	info1, err1 := parseTLSSecret(secret1)
+	if err1 != nil {
+		log.Debugf("secret '%s': cannot parse certificate in 1st cluster, comparing data as is: %s", name, err1.Error())
+	} else {
+		checkTLSCertificateHealth(name, "1st", info1)
+	}
+
+	info2, err2 := parseTLSSecret(secret2)
+	if err2 != nil {
+		log.Debugf("secret '%s': cannot parse certificate in 2nd cluster, comparing data as is: %s", name, err2.Error())
+	} else {
+		checkTLSCertificateHealth(name, "2nd", info2)
+	}
+
+	if err1 != nil || err2 != nil {
+		return map[string]struct{}{}, false
+	}
+
+	if info1.Subject != info2.Subject {
+		log.Infof("secret '%s': certificate subjects are different: '%s' and '%s'", name, info1.Subject, info2.Subject)
+		flag = true
+	}
+
+	if info1.Issuer != info2.Issuer {
+		log.Infof("secret '%s': certificate issuers are different: '%s' and '%s'", name, info1.Issuer, info2.Issuer)
+		flag = true
+	}
+
+	if strings.Join(info1.SANs, ",") != strings.Join(info2.SANs, ",") {
+		log.Infof("secret '%s': certificate SANs are different: [%s] and [%s]", name, strings.Join(info1.SANs, ", "), strings.Join(info2.SANs, ", "))
+		flag = true
+	}
+
+	if info1.KeyAlgorithm != info2.KeyAlgorithm {
+		log.Infof("secret '%s': certificate key algorithms are different: %s and %s", name, info1.KeyAlgorithm, info2.KeyAlgorithm)
+		flag = true
+	}
+
+	if info1.Lifetime != info2.Lifetime {
+		log.Infof("secret '%s': certificate validity periods are different: %s and %s", name, info1.Lifetime, info2.Lifetime)
+		flag = true
+	}
+
+	if info1.KeyMatches != info2.KeyMatches {
+		log.Infof("secret '%s': private key matches the certificate in one cluster only", name)
+		flag = true
+	}
+
+	return map[string]struct{}{
+		v12.TLSCertKey:       {},
+		v12.TLSPrivateKeyKey: {},
+	}, flag
+}
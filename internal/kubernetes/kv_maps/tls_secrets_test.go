@@ -0,0 +1,109 @@
+package kv_maps
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	v12 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"k8s-cluster-comparator/internal/logging"
+)
+
+// newTestTLSSecret issues a self-signed certificate and wraps it into a kubernetes.io/tls secret
+func newTestTLSSecret(t *testing.T, commonName string, dnsNames []string, notBefore time.Time, lifetime time.Duration) *v12.Secret {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("cannot generate key: %s", err.Error())
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     dnsNames,
+		NotBefore:    notBefore,
+		NotAfter:     notBefore.Add(lifetime),
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("cannot create certificate: %s", err.Error())
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("cannot marshal key: %s", err.Error())
+	}
+
+	return &v12.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "tls"},
+		Type:       v12.SecretTypeTLS,
+		Data: map[string][]byte{
+			v12.TLSCertKey:       pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}),
+			v12.TLSPrivateKeyKey: pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		},
+	}
+}
+
+func TestCompareTLSSecrets(t *testing.T) {
+	if err := logging.Configure(false); err != nil {
+		t.Fatalf("cannot configure logging: %s", err.Error())
+	}
+	if err := Init(context.Background()); err != nil {
+		t.Fatalf("cannot init kv_maps package: %s", err.Error())
+	}
+
+	now := time.Now()
+
+	secret1 := newTestTLSSecret(t, "example.com", []string{"example.com", "www.example.com"}, now, 90*24*time.Hour)
+	secret2 := newTestTLSSecret(t, "example.com", []string{"www.example.com", "example.com"}, now.Add(-time.Hour), 90*24*time.Hour)
+
+	comparedKeys, isDiffer := compareTLSSecrets("tls", secret1, secret2)
+	if isDiffer {
+		t.Error("certificates reissued with the same attributes are reported as different")
+	}
+	if _, ok := comparedKeys[v12.TLSCertKey]; !ok {
+		t.Errorf("'%s' key is not reported as compared", v12.TLSCertKey)
+	}
+
+	secret2 = newTestTLSSecret(t, "example.com", []string{"example.com"}, now, 90*24*time.Hour)
+
+	_, isDiffer = compareTLSSecrets("tls", secret1, secret2)
+	if !isDiffer {
+		t.Error("certificates with different SANs are reported as equal")
+	}
+
+	secret2 = newTestTLSSecret(t, "example.com", []string{"example.com", "www.example.com"}, now, 90*24*time.Hour)
+	secret2.Data[v12.TLSPrivateKeyKey] = secret1.Data[v12.TLSPrivateKeyKey]
+
+	_, isDiffer = compareTLSSecrets("tls", secret1, secret2)
+	if !isDiffer {
+		t.Error("private key mismatch in the 2nd cluster is not reported")
+	}
+
+	secret2.Data[v12.TLSCertKey] = []byte("garbage")
+
+	comparedKeys, isDiffer = compareTLSSecrets("tls", secret1, secret2)
+	if isDiffer || len(comparedKeys) != 0 {
+		t.Errorf("unparsable certificate is not left to the comparison of data as is: %v, %v", comparedKeys, isDiffer)
+	}
+	if !compareSecretData("tls", secret1.Data, secret2.Data, comparedKeys) {
+		t.Error("unparsable certificate is not reported")
+	}
+
+	secret1.Data[v12.TLSCertKey] = []byte("garbage")
+	secret1.Data[v12.TLSPrivateKeyKey] = secret2.Data[v12.TLSPrivateKeyKey]
+
+	comparedKeys, isDiffer = compareTLSSecrets("tls", secret1, secret2)
+	if isDiffer || compareSecretData("tls", secret1.Data, secret2.Data, comparedKeys) {
+		t.Error("identical unparsable certificates are reported as different")
+	}
+}
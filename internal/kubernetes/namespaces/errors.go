@@ -0,0 +1,9 @@
+package namespaces
+
+import "errors"
+
+var (
+	ErrorNamespaceLabelsDifferent      = errors.New("the labels of the namespaces are different")
+	ErrorNamespaceAnnotationsDifferent = errors.New("the annotations of the namespaces are different")
+	ErrorNamespacePhaseDifferent       = errors.New("the status of the namespaces is different")
+)
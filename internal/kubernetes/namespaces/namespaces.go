@@ -0,0 +1,177 @@
+package namespaces
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+	"k8s-cluster-comparator/internal/kubernetes/skipper"
+)
+
+var (
+	clusterNames = []string{"1st", "2nd"}
+
+	// skippedNamespaceAnnotations are allocated by the cluster and differ for the same namespace in different clusters
+	skippedNamespaceAnnotations = map[string]struct{}{
+		"openshift.io/sa.scc.mcs":                 {},
+		"openshift.io/sa.scc.supplemental-groups": {},
+		"openshift.io/sa.scc.uid-range":           {},
+	}
+)
+
+// CompareNamespaces compares namespace objects of the compared namespaces in two given k8s-clusters: their existence,
+// labels (e.g. pod security levels), annotations and status. Namespaces absent in both clusters are reported as
+// probable mistakes in the namespaces list
+func CompareNamespaces(clientSet1, clientSet2 kubernetes.Interface, namespaces []string, skipEntityList skipper.SkipEntitiesList) (bool, error) {
+	var (
+		isClustersDiffer bool
+
+		skipEntities = skipEntityList.GetByKind("namespaces")
+	)
+
+	for _, name := range namespaces {
+		if skipEntities.IsSkippedEntity(name) {
+			log.Debugf("namespace %s is skipped from comparison due to its name", name)
+			continue
+		}
+
+		namespace1, err := getNamespace(clientSet1, name)
+		if err != nil {
+			return false, fmt.Errorf("cannot obtain namespace '%s' from 1st cluster: %w", name, err)
+		}
+
+		namespace2, err := getNamespace(clientSet2, name)
+		if err != nil {
+			return false, fmt.Errorf("cannot obtain namespace '%s' from 2nd cluster: %w", name, err)
+		}
+
+		switch {
+		case namespace1 == nil && namespace2 == nil:
+			log.Warnf("namespace '%s' does not exist in both clusters, check the list of compared namespaces", name)
+		case namespace2 == nil:
+			log.Infof("namespace '%s' does not exist in 2nd cluster", name)
+			isClustersDiffer = true
+		case namespace1 == nil:
+			log.Infof("namespace '%s' does not exist in 1st cluster", name)
+			isClustersDiffer = true
+		default:
+			if err := compareNamespaceObjects(*namespace1, *namespace2); err != nil {
+				log.Infof("Namespace %s: %s", name, err.Error())
+				isClustersDiffer = true
+			}
+		}
+	}
+
+	return isClustersDiffer, nil
+}
+
+// CompareNamespaceSets reports namespaces matching the label selector which exist in one cluster only
+func CompareNamespaceSets(clientSet1, clientSet2 kubernetes.Interface, selector labels.Selector, skipEntityList skipper.SkipEntitiesList) (bool, error) {
+	names1, err := getNamespaceNames(clientSet1, selector, skipEntityList.GetByKind("namespaces"))
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain namespaces from 1st cluster: %w", err)
+	}
+
+	names2, err := getNamespaceNames(clientSet2, selector, skipEntityList.GetByKind("namespaces"))
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain namespaces from 2nd cluster: %w", err)
+	}
+
+	onlyIn1, onlyIn2 := common.SubtractStringSets(names1, names2), common.SubtractStringSets(names2, names1)
+
+	if len(onlyIn1) > 0 {
+		log.Infof("namespaces matching '%s' exist in 1st cluster only: %s", selector.String(), strings.Join(onlyIn1, ", "))
+	}
+	if len(onlyIn2) > 0 {
+		log.Infof("namespaces matching '%s' exist in 2nd cluster only: %s", selector.String(), strings.Join(onlyIn2, ", "))
+	}
+
+	return len(onlyIn1) > 0 || len(onlyIn2) > 0, nil
+}
+
+// getNamespace returns the namespace or nil if it does not exist
+func getNamespace(clientSet kubernetes.Interface, name string) (*v1.Namespace, error) {
+	namespace, err := clientSet.CoreV1().Namespaces().Get(name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return namespace, nil
+}
+
+// getNamespaceNames returns names of namespaces matching the label selector
+func getNamespaceNames(clientSet kubernetes.Interface, selector labels.Selector, skipEntities skipper.SkipComponentNames) (map[string]struct{}, error) {
+	namespaces, err := clientSet.CoreV1().Namespaces().List(metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, fmt.Errorf("cannot obtain namespaces list: %w", err)
+	}
+
+	names := make(map[string]struct{}, len(namespaces.Items))
+	for _, namespace := range namespaces.Items {
+		if !skipEntities.IsSkippedEntity(namespace.Name) {
+			names[namespace.Name] = struct{}{}
+		}
+	}
+
+	return names, nil
+}
+
+// compareNamespaceObjects compares labels, annotations and status of namespaces
+func compareNamespaceObjects(namespace1, namespace2 v1.Namespace) error {
+	if differences := describeMapDifferences(namespace1.Labels, namespace2.Labels, common.SkippedKubeLabels); len(differences) > 0 {
+		return fmt.Errorf("%w: %s", ErrorNamespaceLabelsDifferent, strings.Join(differences, "; "))
+	}
+
+	if differences := describeMapDifferences(namespace1.Annotations, namespace2.Annotations, skippedNamespaceAnnotations); len(differences) > 0 {
+		return fmt.Errorf("%w: %s", ErrorNamespaceAnnotationsDifferent, strings.Join(differences, "; "))
+	}
+
+	if namespace1.Status.Phase != namespace2.Status.Phase {
+		return fmt.Errorf("%w. First namespace: '%s'. Second namespace: '%s'", ErrorNamespacePhaseDifferent, namespace1.Status.Phase, namespace2.Status.Phase)
+	}
+
+	return nil
+}
+
+// describeMapDifferences returns sorted keys with different values, e.g. "pod-security.kubernetes.io/enforce: 'restricted' and 'baseline'"
+func describeMapDifferences(map1, map2 map[string]string, skipKeys map[string]struct{}) []string {
+	differences := make([]string, 0)
+
+	for key, value1 := range map1 {
+		if _, ok := skipKeys[key]; ok {
+			continue
+		}
+
+		value2, ok := map2[key]
+		switch {
+		case !ok:
+			differences = append(differences, fmt.Sprintf("%s: '%s' in 1st cluster only", key, value1))
+		case value1 != value2:
+			differences = append(differences, fmt.Sprintf("%s: '%s' and '%s'", key, value1, value2))
+		}
+	}
+
+	for key, value2 := range map2 {
+		if _, ok := skipKeys[key]; ok {
+			continue
+		}
+
+		if _, ok := map1[key]; !ok {
+			differences = append(differences, fmt.Sprintf("%s: '%s' in 2nd cluster only", key, value2))
+		}
+	}
+
+	sort.Strings(differences)
+
+	return differences
+}
@@ -0,0 +1,105 @@
+package namespaces
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s-cluster-comparator/internal/config"
+	"k8s-cluster-comparator/internal/logging"
+)
+
+func newNamespace(name string, namespaceLabels map[string]string) *v1.Namespace {
+	return &v1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: namespaceLabels},
+		Status:     v1.NamespaceStatus{Phase: v1.NamespaceActive},
+	}
+}
+
+func TestCompareNamespaces(t *testing.T) {
+	if err := logging.Configure(false); err != nil {
+		t.Fatalf("cannot configure logging: %s", err.Error())
+	}
+	if err := Init(context.Background()); err != nil {
+		t.Fatalf("cannot init namespaces package: %s", err.Error())
+	}
+
+	restricted := map[string]string{"team": "payments", "pod-security.kubernetes.io/enforce": "restricted"}
+	baseline := map[string]string{"team": "payments", "pod-security.kubernetes.io/enforce": "baseline"}
+
+	clientSet1 := fake.NewSimpleClientset(newNamespace("payments", restricted), newNamespace("payments-jobs", restricted))
+	clientSet2 := fake.NewSimpleClientset(newNamespace("payments", restricted))
+
+	// a misspelled namespace is absent in both clusters and is not a difference
+	isDiffer, err := CompareNamespaces(clientSet1, clientSet2, []string{"payments", "paymnets"}, nil)
+	if err != nil {
+		t.Fatalf("cannot compare namespaces: %s", err.Error())
+	}
+	if isDiffer {
+		t.Error("Equal namespaces are reported as different")
+	}
+
+	isDiffer, err = CompareNamespaces(clientSet1, clientSet2, []string{"payments-jobs"}, nil)
+	if err != nil {
+		t.Fatalf("cannot compare namespaces: %s", err.Error())
+	}
+	if !isDiffer {
+		t.Error("Namespace absent in 2nd cluster is not reported")
+	}
+
+	err = compareNamespaceObjects(*newNamespace("payments", restricted), *newNamespace("payments", baseline))
+	if !errors.Is(err, ErrorNamespaceLabelsDifferent) {
+		t.Errorf("Different pod security levels are not reported: %v", err)
+	}
+
+	isDiffer, err = CompareNamespaceSets(clientSet1, clientSet2, labels.SelectorFromSet(labels.Set{"team": "payments"}), nil)
+	if err != nil {
+		t.Fatalf("cannot compare namespace sets: %s", err.Error())
+	}
+	if !isDiffer {
+		t.Error("Namespace matching the selector in 1st cluster only is not reported")
+	}
+}
+
+func TestResolveNamespaces(t *testing.T) {
+	if err := logging.Configure(false); err != nil {
+		t.Fatalf("cannot configure logging: %s", err.Error())
+	}
+	if err := Init(context.Background()); err != nil {
+		t.Fatalf("cannot init namespaces package: %s", err.Error())
+	}
+
+	payments := map[string]string{"team": "payments"}
+
+	clientSet1 := fake.NewSimpleClientset(newNamespace("kube-system", nil), newNamespace("payments", payments),
+		newNamespace("payments-jobs", payments), newNamespace("search", nil))
+	clientSet2 := fake.NewSimpleClientset(newNamespace("kube-public", nil), newNamespace("payments", payments),
+		newNamespace("payments-v2", payments), newNamespace("payments-tmp", map[string]string{"team": "payments", "temporary": "true"}))
+
+	filter := &config.NamespaceFilter{
+		IncludeSelector: labels.SelectorFromSet(labels.Set{"team": "payments"}),
+		ExcludeSelector: labels.SelectorFromSet(labels.Set{"temporary": "true"}),
+	}
+	for _, pattern := range []string{"kube-*", "/-jobs$/"} {
+		namespacePattern, err := config.ParseNamespacePattern(pattern)
+		if err != nil {
+			t.Fatalf("cannot parse namespace pattern: %s", err.Error())
+		}
+		filter.Exclude = append(filter.Exclude, namespacePattern)
+	}
+
+	resolved, err := ResolveNamespaces(clientSet1, clientSet2, filter)
+	if err != nil {
+		t.Fatalf("cannot resolve namespaces: %s", err.Error())
+	}
+
+	if strings.Join(resolved, ",") != "payments,payments-v2" {
+		t.Errorf("expected union of selected namespaces 'payments,payments-v2', got '%s'", strings.Join(resolved, ","))
+	}
+}
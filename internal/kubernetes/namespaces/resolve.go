@@ -0,0 +1,42 @@
+package namespaces
+
+import (
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/config"
+)
+
+// ResolveNamespaces returns sorted names of namespaces of both clusters passing the filter. A namespace is selected
+// when it passes the filter in any of the clusters, so namespaces existing in one cluster only are compared too
+func ResolveNamespaces(clientSet1, clientSet2 kubernetes.Interface, filter *config.NamespaceFilter) ([]string, error) {
+	selected := make(map[string]struct{})
+
+	for i, clientSet := range []kubernetes.Interface{clientSet1, clientSet2} {
+		namespaces, err := clientSet.CoreV1().Namespaces().List(metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("cannot obtain namespaces list from %s cluster: %w", clusterNames[i], err)
+		}
+
+		for _, namespace := range namespaces.Items {
+			if filter.IsNamespaceSelected(namespace.Name, namespace.Labels) {
+				selected[namespace.Name] = struct{}{}
+			} else {
+				log.Debugf("namespace %s of %s cluster is skipped by the namespace filter", namespace.Name, clusterNames[i])
+			}
+		}
+	}
+
+	names := make([]string, 0, len(selected))
+	for name := range selected {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	log.Infof("%d namespaces are selected for comparison", len(names))
+
+	return names, nil
+}
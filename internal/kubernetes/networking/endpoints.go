@@ -0,0 +1,214 @@
+package networking
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	v12 "k8s.io/api/core/v1"
+	discoveryv1beta1 "k8s.io/api/discovery/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+	"k8s-cluster-comparator/internal/kubernetes/skipper"
+)
+
+// serviceEndpointsSummary describes how a service is backed by endpoints in a cluster
+type serviceEndpointsSummary struct {
+	Ready    int
+	NotReady int
+
+	Ports map[string]struct{}
+}
+
+// CompareServiceEndpoints compares readiness of endpoints backing services which exist in both k8s-clusters
+func CompareServiceEndpoints(clientSet1, clientSet2 kubernetes.Interface, namespace string, skipEntityList skipper.SkipEntitiesList) (bool, error) {
+	var (
+		isClustersDiffer bool
+	)
+
+	services1, err := getServices(clientSet1, namespace)
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain services from 1st cluster: %w", err)
+	}
+	services2, err := getServices(clientSet2, namespace)
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain services from 2nd cluster: %w", err)
+	}
+
+	summaries1, err := getEndpointsSummaries(clientSet1, namespace)
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain endpoints from 1st cluster: %w", err)
+	}
+	summaries2, err := getEndpointsSummaries(clientSet2, namespace)
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain endpoints from 2nd cluster: %w", err)
+	}
+
+	mapServices1, mapServices2 := prepareServiceMaps(services1, services2, skipEntityList.GetByKind("services"))
+
+	for name, index1 := range mapServices1 {
+		index2, ok := mapServices2[name]
+		if !ok {
+			continue
+		}
+
+		if services1[index1.Index].Spec.Type == v12.ServiceTypeExternalName || services2[index2.Index].Spec.Type == v12.ServiceTypeExternalName {
+			continue
+		}
+
+		if compareEndpointsSummaries(name, summaries1[name], summaries2[name]) {
+			isClustersDiffer = true
+		}
+	}
+
+	return isClustersDiffer, nil
+}
+
+// compareEndpointsSummaries compares endpoints of a service in two clusters
+func compareEndpointsSummaries(name string, summary1, summary2 serviceEndpointsSummary) bool {
+	var (
+		flag bool
+	)
+
+	log.Debugf("service '%s' endpoints: ready %d/%d, not ready %d/%d", name, summary1.Ready, summary2.Ready, summary1.NotReady, summary2.NotReady)
+
+	if summary1.Ready > 0 && summary2.Ready == 0 {
+		log.Infof("service '%s' is backed by %d ready endpoint(s) in 1st cluster but has no ready endpoints in 2nd cluster", name, summary1.Ready)
+		flag = true
+	} else if summary2.Ready > 0 && summary1.Ready == 0 {
+		log.Infof("service '%s' is backed by %d ready endpoint(s) in 2nd cluster but has no ready endpoints in 1st cluster", name, summary2.Ready)
+		flag = true
+	} else if summary1.Ready != summary2.Ready || summary1.NotReady != summary2.NotReady {
+		log.Infof("service '%s' endpoints count is different: %d ready, %d not ready in 1st cluster and %d ready, %d not ready in 2nd cluster", name, summary1.Ready, summary1.NotReady, summary2.Ready, summary2.NotReady)
+		flag = true
+	}
+
+	if summary1.Ready > 0 && summary2.Ready > 0 && !arePortSetsEqual(summary1.Ports, summary2.Ports) {
+		log.Infof("service '%s' endpoints expose different ports: [%s] and [%s]", name, joinPortSet(summary1.Ports), joinPortSet(summary2.Ports))
+		flag = true
+	}
+
+	return flag
+}
+
+// getEndpointsSummaries collects endpoints summaries for all services in a namespace. EndpointSlices are used where the cluster serves them
+func getEndpointsSummaries(clientSet kubernetes.Interface, namespace string) (map[string]serviceEndpointsSummary, error) {
+	isServed, err := common.IsResourceServed(clientSet, discoveryv1beta1.SchemeGroupVersion.String(), "endpointslices")
+	if err != nil {
+		log.Debugf("cannot discover endpointslices support, falling back to endpoints: %s", err.Error())
+	}
+
+	if isServed {
+		return getEndpointSlicesSummaries(clientSet, namespace)
+	}
+
+	endpoints, err := clientSet.CoreV1().Endpoints(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make(map[string]serviceEndpointsSummary, len(endpoints.Items))
+
+	for _, ep := range endpoints.Items {
+		summary := serviceEndpointsSummary{
+			Ports: make(map[string]struct{}),
+		}
+
+		for _, subset := range ep.Subsets {
+			summary.Ready += len(subset.Addresses)
+			summary.NotReady += len(subset.NotReadyAddresses)
+
+			for _, port := range subset.Ports {
+				summary.Ports[fmt.Sprintf("%s:%d/%s", port.Name, port.Port, port.Protocol)] = struct{}{}
+			}
+		}
+
+		summaries[ep.Name] = summary
+	}
+
+	return summaries, nil
+}
+
+// getEndpointSlicesSummaries collects endpoints summaries for all services in a namespace from EndpointSlices
+func getEndpointSlicesSummaries(clientSet kubernetes.Interface, namespace string) (map[string]serviceEndpointsSummary, error) {
+	slices, err := clientSet.DiscoveryV1beta1().EndpointSlices(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make(map[string]serviceEndpointsSummary)
+
+	for _, slice := range slices.Items {
+		serviceName, ok := slice.Labels[discoveryv1beta1.LabelServiceName]
+		if !ok {
+			continue
+		}
+
+		summary, ok := summaries[serviceName]
+		if !ok {
+			summary.Ports = make(map[string]struct{})
+		}
+
+		for _, endpoint := range slice.Endpoints {
+			// unknown readiness must be interpreted as ready
+			if endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready {
+				summary.Ready += len(endpoint.Addresses)
+			} else {
+				summary.NotReady += len(endpoint.Addresses)
+			}
+		}
+
+		for _, port := range slice.Ports {
+			var (
+				portName     string
+				portNumber   int32
+				portProtocol = v12.ProtocolTCP
+			)
+
+			if port.Name != nil {
+				portName = *port.Name
+			}
+			if port.Port != nil {
+				portNumber = *port.Port
+			}
+			if port.Protocol != nil {
+				portProtocol = *port.Protocol
+			}
+
+			summary.Ports[fmt.Sprintf("%s:%d/%s", portName, portNumber, portProtocol)] = struct{}{}
+		}
+
+		summaries[serviceName] = summary
+	}
+
+	return summaries, nil
+}
+
+// arePortSetsEqual compares two sets of ports
+func arePortSetsEqual(ports1, ports2 map[string]struct{}) bool {
+	if len(ports1) != len(ports2) {
+		return false
+	}
+
+	for port := range ports1 {
+		if _, ok := ports2[port]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// joinPortSet returns a sorted comma separated list of ports
+func joinPortSet(ports map[string]struct{}) string {
+	list := make([]string, 0, len(ports))
+	for port := range ports {
+		list = append(list, port)
+	}
+
+	sort.Strings(list)
+
+	return strings.Join(list, ", ")
+}
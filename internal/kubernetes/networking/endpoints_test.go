@@ -0,0 +1,90 @@
+package networking
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s-cluster-comparator/internal/logging"
+)
+
+func initEnvironmentForEndpointsTest(readyAddresses2, notReadyAddresses2 []v1.EndpointAddress) {
+	service := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "testService",
+			Namespace: "default",
+		},
+	}
+	ports := []v1.EndpointPort{
+		{
+			Name:     "http",
+			Port:     8080,
+			Protocol: v1.ProtocolTCP,
+		},
+	}
+
+	clusterClientSet1 = fake.NewSimpleClientset(service.DeepCopy(), &v1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "testService",
+			Namespace: "default",
+		},
+		Subsets: []v1.EndpointSubset{
+			{
+				Addresses: []v1.EndpointAddress{{IP: "10.0.0.1"}, {IP: "10.0.0.2"}},
+				Ports:     ports,
+			},
+		},
+	})
+	clusterClientSet2 = fake.NewSimpleClientset(service.DeepCopy(), &v1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "testService",
+			Namespace: "default",
+		},
+		Subsets: []v1.EndpointSubset{
+			{
+				Addresses:         readyAddresses2,
+				NotReadyAddresses: notReadyAddresses2,
+				Ports:             ports,
+			},
+		},
+	})
+}
+
+func TestCompareServiceEndpoints(t *testing.T) {
+	if err := logging.Configure(false); err != nil {
+		t.Fatalf("cannot configure logging: %s", err.Error())
+	}
+	if err := Init(context.Background()); err != nil {
+		t.Fatalf("cannot init networking package: %s", err.Error())
+	}
+
+	initEnvironmentForEndpointsTest([]v1.EndpointAddress{{IP: "10.1.0.1"}, {IP: "10.1.0.2"}}, nil)
+	isDiffer, err := CompareServiceEndpoints(clusterClientSet1, clusterClientSet2, "default", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if isDiffer {
+		t.Error("services backed by the same number of ready endpoints in both clusters are reported as different")
+	}
+
+	initEnvironmentForEndpointsTest([]v1.EndpointAddress{{IP: "10.1.0.1"}}, []v1.EndpointAddress{{IP: "10.1.0.3"}})
+	isDiffer, err = CompareServiceEndpoints(clusterClientSet1, clusterClientSet2, "default", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !isDiffer {
+		t.Error("different ready and not ready endpoints counts are not reported")
+	}
+
+	initEnvironmentForEndpointsTest(nil, []v1.EndpointAddress{{IP: "10.1.0.3"}})
+	isDiffer, err = CompareServiceEndpoints(clusterClientSet1, clusterClientSet2, "default", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !isDiffer {
+		t.Error("service without ready endpoints in 2nd cluster is not reported")
+	}
+}
@@ -11,6 +11,18 @@ var (
 
 	ErrorTypeInServicesDifferent = errors.New("the type in the services is different")
 
+	ErrorHeadlessInServicesDifferent       = errors.New("the services are headless in one cluster only")
+	ErrorClusterIPInServicesDifferent      = errors.New("the cluster IP in the services is different")
+	ErrorHealthCheckNodePortDifferent      = errors.New("the health check node port in the services is different")
+	ErrorExternalNameInServicesDifferent   = errors.New("the external name in the services is different")
+	ErrorSessionAffinityDifferent          = errors.New("the session affinity in the services is different")
+	ErrorExternalTrafficPolicyDifferent    = errors.New("the external traffic policy in the services is different")
+	ErrorPublishNotReadyAddressesDifferent = errors.New("the publishNotReadyAddresses in the services is different")
+	ErrorLoadBalancerSourceRangesDifferent = errors.New("the load balancer source ranges in the services are different")
+	ErrorIPFamilyInServicesDifferent       = errors.New("the IP families in the services are different")
+	ErrorInternalTrafficPolicyDifferent    = errors.New("the internal traffic policy in the services is different")
+
+	ErrorIngressClassDifferent   = errors.New("the ingress class in the ingresses is different")
 	ErrorTLSCountDifferent       = errors.New("the TLS count in the ingresses are different")
 	ErrorTLSInIngressesDifferent = errors.New("the TLS in the ingresses are different")
 
@@ -21,10 +33,19 @@ var (
 	ErrorBackendInIngressesDifferent   = errors.New("the backend in the ingresses are different")
 	ErrorBackendServicePortDifferent   = errors.New("the service port in the backend are different")
 	ErrorServiceNameInBackendDifferent = errors.New("the service name in the backend are different")
-	ErrorRulesCountDifferent           = errors.New("the rules count in the ingresses is different")
 	ErrorRulesInIngressesDifferent     = errors.New("the rules in the ingresses are different")
 	ErrorHostNameInRuleDifferent       = errors.New("the hosts name in the rule are different")
 	ErrorHTTPInIngressesDifferent      = errors.New("the HTTP in the ingresses is different")
-	ErrorPathsCountDifferent           = errors.New("the paths count in the ingresses is different")
 	ErrorPathValueDifferent            = errors.New("the path value in the ingresses is different")
+	ErrorPathTypeDifferent             = errors.New("the path type in the ingresses is different")
+	ErrorResourceBackendDifferent      = errors.New("the resource backend in the ingresses is different")
+
+	ErrorIngressControllerDifferent      = errors.New("the controller in the ingress classes is different")
+	ErrorIngressClassParametersDifferent = errors.New("the parameters in the ingress classes are different")
+	ErrorDefaultIngressClassDifferent    = errors.New("the ingress class is the default one in one cluster only")
+
+	ErrorPodSelectorInPoliciesDifferent = errors.New("the pod selector in the network policies is different")
+	ErrorPolicyTypesDifferent           = errors.New("the policy types in the network policies are different")
+	ErrorIngressRulesDifferent          = errors.New("the ingress rules in the network policies are different")
+	ErrorEgressRulesDifferent           = errors.New("the egress rules in the network policies are different")
 )
@@ -0,0 +1,156 @@
+package networking
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	ingressClassAnnotation = "kubernetes.io/ingress.class"
+)
+
+// ingressAnnotationKind describes how values of a well-known ingress controller annotation are interpreted
+type ingressAnnotationKind int
+
+const (
+	annotationKindBool ingressAnnotationKind = iota
+	annotationKindInt
+	annotationKindSize
+	annotationKindCaseInsensitive
+	annotationKindUnorderedList
+)
+
+var (
+	// knownIngressAnnotations contains nginx and traefik annotations whose values have a well-known type
+	knownIngressAnnotations = map[string]ingressAnnotationKind{
+		"nginx.ingress.kubernetes.io/ssl-redirect":            annotationKindBool,
+		"nginx.ingress.kubernetes.io/force-ssl-redirect":      annotationKindBool,
+		"nginx.ingress.kubernetes.io/use-regex":               annotationKindBool,
+		"nginx.ingress.kubernetes.io/enable-cors":             annotationKindBool,
+		"nginx.ingress.kubernetes.io/proxy-body-size":         annotationKindSize,
+		"nginx.ingress.kubernetes.io/proxy-buffer-size":       annotationKindSize,
+		"nginx.ingress.kubernetes.io/client-body-buffer-size": annotationKindSize,
+		"nginx.ingress.kubernetes.io/proxy-connect-timeout":   annotationKindInt,
+		"nginx.ingress.kubernetes.io/proxy-read-timeout":      annotationKindInt,
+		"nginx.ingress.kubernetes.io/proxy-send-timeout":      annotationKindInt,
+		"nginx.ingress.kubernetes.io/auth-type":               annotationKindCaseInsensitive,
+		"nginx.ingress.kubernetes.io/auth-secret-type":        annotationKindCaseInsensitive,
+		"nginx.ingress.kubernetes.io/backend-protocol":        annotationKindCaseInsensitive,
+		"nginx.ingress.kubernetes.io/whitelist-source-range":  annotationKindUnorderedList,
+
+		"ingress.kubernetes.io/ssl-redirect":                   annotationKindBool,
+		"ingress.kubernetes.io/auth-type":                      annotationKindCaseInsensitive,
+		"traefik.ingress.kubernetes.io/router.tls":             annotationKindBool,
+		"traefik.ingress.kubernetes.io/router.entrypoints":     annotationKindUnorderedList,
+		"traefik.ingress.kubernetes.io/whitelist-source-range": annotationKindUnorderedList,
+	}
+)
+
+// normalizeIngressAnnotation returns a canonical form of a well-known annotation value, so that differently spelled equal values match
+func normalizeIngressAnnotation(key, value string) string {
+	kind, ok := knownIngressAnnotations[key]
+	if !ok {
+		return value
+	}
+
+	value = strings.TrimSpace(value)
+
+	switch kind {
+	case annotationKindBool:
+		if b, err := strconv.ParseBool(value); err == nil {
+			return strconv.FormatBool(b)
+		}
+	case annotationKindInt:
+		if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return strconv.FormatInt(i, 10)
+		}
+	case annotationKindSize:
+		if size, err := parseNginxSize(value); err == nil {
+			return strconv.FormatInt(size, 10)
+		}
+	case annotationKindCaseInsensitive:
+		return strings.ToLower(value)
+	case annotationKindUnorderedList:
+		items := strings.Split(value, ",")
+		for i := range items {
+			items[i] = strings.TrimSpace(items[i])
+		}
+		sort.Strings(items)
+
+		return strings.Join(items, ",")
+	}
+
+	return value
+}
+
+// parseNginxSize parses nginx size values (e.g. 512, 8k, 10m, 1G) into bytes
+func parseNginxSize(value string) (int64, error) {
+	if value == "" {
+		return 0, fmt.Errorf("empty size")
+	}
+
+	multiplier := int64(1)
+
+	switch value[len(value)-1] {
+	case 'k', 'K':
+		multiplier = 1 << 10
+	case 'm', 'M':
+		multiplier = 1 << 20
+	case 'g', 'G':
+		multiplier = 1 << 30
+	}
+
+	if multiplier != 1 {
+		value = value[:len(value)-1]
+	}
+
+	size, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return size * multiplier, nil
+}
+
+// getIngressClass returns the ingress class set either by ingressClassName field or by the legacy annotation
+func getIngressClass(ingress ingressModel) string {
+	if ingress.IngressClassName != nil {
+		return *ingress.IngressClassName
+	}
+
+	return ingress.Annotations[ingressClassAnnotation]
+}
+
+// compareIngressAnnotations compares ingress annotations interpreting well-known controller annotations. It returns keys of differing annotations
+func compareIngressAnnotations(annotations1, annotations2 map[string]string) []string {
+	var (
+		diffKeys []string
+	)
+
+	for key, value1 := range annotations1 {
+		if key == ingressClassAnnotation {
+			continue
+		}
+
+		value2, ok := annotations2[key]
+		if !ok || normalizeIngressAnnotation(key, value1) != normalizeIngressAnnotation(key, value2) {
+			diffKeys = append(diffKeys, key)
+		}
+	}
+
+	for key := range annotations2 {
+		if key == ingressClassAnnotation {
+			continue
+		}
+
+		if _, ok := annotations1[key]; !ok {
+			diffKeys = append(diffKeys, key)
+		}
+	}
+
+	sort.Strings(diffKeys)
+
+	return diffKeys
+}
@@ -0,0 +1,57 @@
+package networking
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCompareIngressAnnotations(t *testing.T) {
+	annotations1 := map[string]string{
+		"kubernetes.io/ingress.class":                      "nginx",
+		"nginx.ingress.kubernetes.io/ssl-redirect":         "true",
+		"nginx.ingress.kubernetes.io/proxy-body-size":      "10m",
+		"nginx.ingress.kubernetes.io/rewrite-target":       "/$2",
+		"traefik.ingress.kubernetes.io/router.entrypoints": "web,websecure",
+	}
+	annotations2 := map[string]string{
+		"nginx.ingress.kubernetes.io/ssl-redirect":         "True",
+		"nginx.ingress.kubernetes.io/proxy-body-size":      "10M",
+		"nginx.ingress.kubernetes.io/rewrite-target":       "/$2",
+		"traefik.ingress.kubernetes.io/router.entrypoints": "websecure, web",
+	}
+
+	if diffKeys := compareIngressAnnotations(annotations1, annotations2); len(diffKeys) > 0 {
+		t.Errorf("semantically equal annotations are reported as different: %v", diffKeys)
+	}
+
+	annotations2["nginx.ingress.kubernetes.io/proxy-body-size"] = "1g"
+	annotations2["nginx.ingress.kubernetes.io/rewrite-target"] = "/$1"
+
+	diffKeys := compareIngressAnnotations(annotations1, annotations2)
+	if len(diffKeys) != 2 || diffKeys[0] != "nginx.ingress.kubernetes.io/proxy-body-size" || diffKeys[1] != "nginx.ingress.kubernetes.io/rewrite-target" {
+		t.Errorf("unexpected differing annotations: %v", diffKeys)
+	}
+}
+
+func TestCompareIngressClass(t *testing.T) {
+	className := "nginx"
+
+	ingress1 := ingressModel{
+		Name:             "testIngress",
+		IngressClassName: &className,
+	}
+	ingress2 := ingressModel{
+		Name:        "testIngress",
+		Annotations: map[string]string{ingressClassAnnotation: "nginx"},
+	}
+
+	if err := compareSpecInIngresses(ingress1, ingress2); err != nil {
+		t.Error("ingress class set by the field and by the annotation is reported as different: ", err)
+	}
+
+	ingress2.Annotations[ingressClassAnnotation] = "traefik"
+
+	if err := compareSpecInIngresses(ingress1, ingress2); !errors.Is(errors.Unwrap(err), ErrorIngressClassDifferent) {
+		t.Error("Error expected: 'the ingress class in the ingresses is different'. But it was returned: ", err)
+	}
+}
@@ -0,0 +1,237 @@
+package networking
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+	"k8s-cluster-comparator/internal/kubernetes/kv_maps"
+	"k8s-cluster-comparator/internal/kubernetes/skipper"
+	"k8s-cluster-comparator/internal/kubernetes/types"
+)
+
+const (
+	defaultIngressClassAnnotation = "ingressclass.kubernetes.io/is-default-class"
+)
+
+var (
+	// ingressClassGroupVersions lists group versions serving ingress classes from the most to the least preferred one
+	ingressClassGroupVersions = []string{
+		ingressGroupVersionNetworkingV1,
+		ingressGroupVersionNetworkingV1beta1,
+	}
+)
+
+// ingressClassList mirrors IngressClassList of networking.k8s.io/v1 and networking.k8s.io/v1beta1 which share the
+// same layout. Ingress classes are unknown to the vendored client-go
+type ingressClassList struct {
+	Items []ingressClass `json:"items"`
+}
+
+// ingressClass mirrors an IngressClass of any served version
+type ingressClass struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec struct {
+		Controller string                           `json:"controller,omitempty"`
+		Parameters *ingressClassParametersReference `json:"parameters,omitempty"`
+	} `json:"spec,omitempty"`
+}
+
+// ingressClassParametersReference mirrors a reference to the resource holding parameters of the ingress class
+type ingressClassParametersReference struct {
+	APIGroup  *string `json:"apiGroup,omitempty"`
+	Kind      string  `json:"kind"`
+	Name      string  `json:"name"`
+	Scope     *string `json:"scope,omitempty"`
+	Namespace *string `json:"namespace,omitempty"`
+}
+
+// CompareIngressClasses compares list of cluster-scoped ingress classes objects in two given k8s-clusters
+func CompareIngressClasses(clientSet1, clientSet2 kubernetes.Interface, skipEntityList skipper.SkipEntitiesList) (bool, error) {
+	ingressClasses1, err := getIngressClasses(clientSet1)
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain ingress classes from 1st cluster: %w", err)
+	}
+
+	ingressClasses2, err := getIngressClasses(clientSet2)
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain ingress classes from 2nd cluster: %w", err)
+	}
+
+	mapIngressClasses1, mapIngressClasses2 := prepareIngressClassMaps(ingressClasses1, ingressClasses2, skipEntityList.GetByKind("ingressclasses"))
+
+	return setInformationAboutIngressClasses(mapIngressClasses1, mapIngressClasses2, ingressClasses1, ingressClasses2), nil
+}
+
+// getIngressClasses returns ingress classes using the most preferred API version served by the cluster.
+// A cluster which does not serve ingress classes has none of them
+func getIngressClasses(clientSet kubernetes.Interface) ([]ingressClass, error) {
+	groupVersion, err := common.GetServedGroupVersion(clientSet, "ingressclasses", ingressClassGroupVersions...)
+	if errors.Is(err, common.ErrResourceNotServed) {
+		log.Debugf("ingress classes are not served by the cluster: %s", err.Error())
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("ingress classes are obtained using '%s' API", groupVersion)
+
+	list := ingressClassList{}
+
+	if err := common.GetRawResourceList(clientSet, groupVersion, "", "ingressclasses", &list); err != nil {
+		return nil, fmt.Errorf("cannot obtain ingress classes using '%s' API: %w", groupVersion, err)
+	}
+
+	return list.Items, nil
+}
+
+// prepareIngressClassMaps add value ingress classes in map
+func prepareIngressClassMaps(ingressClasses1, ingressClasses2 []ingressClass, skipEntities skipper.SkipComponentNames) (map[string]types.IsAlreadyComparedFlag, map[string]types.IsAlreadyComparedFlag) { //nolint:gocritic,unused
+	mapIngressClasses1 := make(map[string]types.IsAlreadyComparedFlag)
+	mapIngressClasses2 := make(map[string]types.IsAlreadyComparedFlag)
+	var indexCheck types.IsAlreadyComparedFlag
+
+	for index, value := range ingressClasses1 {
+		if skipEntities.IsSkippedEntity(value.Name) {
+			log.Debugf("ingress class %s is skipped from comparison due to its name", value.Name)
+			continue
+		}
+		indexCheck.Index = index
+		mapIngressClasses1[value.Name] = indexCheck
+	}
+	for index, value := range ingressClasses2 {
+		if skipEntities.IsSkippedEntity(value.Name) {
+			log.Debugf("ingress class %s is skipped from comparison due to its name", value.Name)
+			continue
+		}
+		indexCheck.Index = index
+		mapIngressClasses2[value.Name] = indexCheck
+	}
+
+	return mapIngressClasses1, mapIngressClasses2
+}
+
+// setInformationAboutIngressClasses set information about ingress classes
+func setInformationAboutIngressClasses(map1, map2 map[string]types.IsAlreadyComparedFlag, ingressClasses1, ingressClasses2 []ingressClass) bool {
+	var (
+		flag bool
+	)
+
+	if len(map1) != len(map2) {
+		log.Infof("ingress classes counts are different")
+		flag = true
+	}
+
+	wg := &sync.WaitGroup{}
+	channel := make(chan bool, len(map1))
+
+	for name, index1 := range map1 {
+		if index2, ok := map2[name]; ok {
+			wg.Add(1)
+
+			index1.Check = true
+			map1[name] = index1
+			index2.Check = true
+			map2[name] = index2
+
+			go compareIngressClassSpecInternals(wg, channel, name, &ingressClasses1[index1.Index], &ingressClasses2[index2.Index])
+		} else {
+			log.Infof("ingress class '%s' does not exist in 2nd cluster", name)
+			flag = true
+			channel <- flag
+		}
+	}
+
+	wg.Wait()
+
+	close(channel)
+
+	for ch := range channel {
+		if ch {
+			flag = true
+		}
+	}
+	for name, index := range map2 {
+		if !index.Check {
+			log.Infof("ingress class '%s' does not exist in 1st cluster", name)
+			flag = true
+		}
+	}
+
+	return flag
+}
+
+func compareIngressClassSpecInternals(wg *sync.WaitGroup, channel chan bool, name string, ingressClass1, ingressClass2 *ingressClass) {
+	var (
+		flag bool
+	)
+	defer func() {
+		wg.Done()
+	}()
+
+	log.Debugf("----- Start checking ingress class: '%s' -----", name)
+
+	if !kv_maps.AreKVMapsEqual(ingressClass1.Labels, ingressClass2.Labels, common.SkippedKubeLabels) {
+		log.Infof("metadata of ingress class '%s' differs: different labels", ingressClass1.Name)
+		channel <- true
+		return
+	}
+
+	err := compareSpecInIngressClasses(*ingressClass1, *ingressClass2)
+	if err != nil {
+		log.Infof("IngressClass %s: %s", name, err.Error())
+		flag = true
+	}
+
+	log.Debugf("----- End checking ingress class: '%s' -----", name)
+	channel <- flag
+}
+
+// compareSpecInIngressClasses compares the controller, the parameters and the default class marker of ingress classes
+func compareSpecInIngressClasses(ingressClass1, ingressClass2 ingressClass) error {
+	if ingressClass1.Spec.Controller != ingressClass2.Spec.Controller {
+		return fmt.Errorf("%w. First ingress class: '%s'. Second ingress class: '%s'", ErrorIngressControllerDifferent, ingressClass1.Spec.Controller, ingressClass2.Spec.Controller)
+	}
+
+	parameters1, parameters2 := formatIngressClassParameters(ingressClass1.Spec.Parameters), formatIngressClassParameters(ingressClass2.Spec.Parameters)
+	if parameters1 != parameters2 {
+		return fmt.Errorf("%w. First ingress class: '%s'. Second ingress class: '%s'", ErrorIngressClassParametersDifferent, parameters1, parameters2)
+	}
+
+	isDefault1, isDefault2 := isDefaultIngressClass(ingressClass1), isDefaultIngressClass(ingressClass2)
+	if isDefault1 != isDefault2 {
+		return fmt.Errorf("%w. First ingress class: %t. Second ingress class: %t", ErrorDefaultIngressClassDifferent, isDefault1, isDefault2)
+	}
+
+	return nil
+}
+
+// formatIngressClassParameters returns the parameters reference in the canonical form, the scope defaults to Cluster
+func formatIngressClassParameters(parameters *ingressClassParametersReference) string {
+	if parameters == nil {
+		return "<none>"
+	}
+
+	result := parameters.Kind
+	if parameters.APIGroup != nil && *parameters.APIGroup != "" {
+		result = *parameters.APIGroup + "/" + result
+	}
+	result += "/" + parameters.Name
+
+	if parameters.Scope != nil && *parameters.Scope == "Namespace" && parameters.Namespace != nil {
+		result += " in namespace " + *parameters.Namespace
+	}
+
+	return result
+}
+
+// isDefaultIngressClass checks whether the ingress class is marked as the default one
+func isDefaultIngressClass(ingressClass ingressClass) bool {
+	return ingressClass.Annotations[defaultIngressClassAnnotation] == "true"
+}
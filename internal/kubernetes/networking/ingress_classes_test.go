@@ -0,0 +1,44 @@
+package networking
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestCompareSpecInIngressClasses(t *testing.T) {
+	var list1, list2 ingressClassList
+
+	err := json.Unmarshal([]byte(`{"items": [{"metadata": {"name": "nginx", "annotations": {"ingressclass.kubernetes.io/is-default-class": "true"}},
+		"spec": {"controller": "k8s.io/ingress-nginx", "parameters": {"apiGroup": "k8s.example.com", "kind": "IngressParameters", "name": "external"}}}]}`), &list1)
+	if err != nil {
+		t.Fatalf("cannot decode ingress classes: %s", err.Error())
+	}
+
+	err = json.Unmarshal([]byte(`{"items": [{"metadata": {"name": "nginx"},
+		"spec": {"controller": "k8s.io/ingress-nginx", "parameters": {"apiGroup": "k8s.example.com", "kind": "IngressParameters", "name": "external", "scope": "Cluster"}}}]}`), &list2)
+	if err != nil {
+		t.Fatalf("cannot decode ingress classes: %s", err.Error())
+	}
+
+	if err := compareSpecInIngressClasses(list1.Items[0], list2.Items[0]); !errors.Is(err, ErrorDefaultIngressClassDifferent) {
+		t.Error("Error expected: 'the ingress class is the default one in one cluster only'. But it was returned: ", err)
+	}
+
+	list2.Items[0].Annotations = map[string]string{defaultIngressClassAnnotation: "true"}
+	if err := compareSpecInIngressClasses(list1.Items[0], list2.Items[0]); err != nil {
+		t.Error("Equal ingress classes are reported as different: ", err)
+	}
+
+	ingressClass2 := list2.Items[0]
+	ingressClass2.Spec.Controller = "example.com/ingress-controller"
+	if err := compareSpecInIngressClasses(list1.Items[0], ingressClass2); !errors.Is(err, ErrorIngressControllerDifferent) {
+		t.Error("Error expected: 'the controller in the ingress classes is different'. But it was returned: ", err)
+	}
+
+	ingressClass2 = list2.Items[0]
+	ingressClass2.Spec.Parameters = nil
+	if err := compareSpecInIngressClasses(list1.Items[0], ingressClass2); !errors.Is(err, ErrorIngressClassParametersDifferent) {
+		t.Error("Error expected: 'the parameters in the ingress classes are different'. But it was returned: ", err)
+	}
+}
@@ -0,0 +1,303 @@
+package networking
+
+import (
+	"fmt"
+
+	v12 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+)
+
+const (
+	ingressGroupVersionNetworkingV1      = "networking.k8s.io/v1"
+	ingressGroupVersionNetworkingV1beta1 = "networking.k8s.io/v1beta1"
+	ingressGroupVersionExtensionsV1beta1 = "extensions/v1beta1"
+)
+
+var (
+	// ingressGroupVersions lists group versions serving ingresses from the most to the least preferred one
+	ingressGroupVersions = []string{
+		ingressGroupVersionNetworkingV1,
+		ingressGroupVersionNetworkingV1beta1,
+		ingressGroupVersionExtensionsV1beta1,
+	}
+)
+
+// ingressModel is a version-independent representation of an ingress used for comparison
+type ingressModel struct {
+	Name       string
+	APIVersion string
+
+	Labels      map[string]string
+	Annotations map[string]string
+
+	IngressClassName *string
+	DefaultBackend   *ingressBackendModel
+	TLS              []ingressTLSModel
+	Rules            []ingressRuleModel
+}
+
+// ingressBackendModel is a version-independent representation of an ingress backend
+type ingressBackendModel struct {
+	ServiceName       string
+	ServicePortName   string
+	ServicePortNumber int32
+
+	Resource *v12.TypedLocalObjectReference
+}
+
+// ingressTLSModel is a version-independent representation of an ingress TLS block
+type ingressTLSModel struct {
+	Hosts      []string `json:"hosts,omitempty"`
+	SecretName string   `json:"secretName,omitempty"`
+}
+
+// ingressRuleModel is a version-independent representation of an ingress rule
+type ingressRuleModel struct {
+	Host string
+	HTTP *ingressHTTPModel
+}
+
+// ingressHTTPModel is a version-independent representation of HTTP paths of an ingress rule
+type ingressHTTPModel struct {
+	Paths []ingressPathModel
+}
+
+// ingressPathModel is a version-independent representation of an ingress HTTP path
+type ingressPathModel struct {
+	Path     string
+	PathType *string
+	Backend  ingressBackendModel
+}
+
+// ingressV1List mirrors networking.k8s.io/v1 IngressList which is unknown to the vendored client-go
+type ingressV1List struct {
+	Items []struct {
+		metav1.ObjectMeta `json:"metadata,omitempty"`
+
+		Spec struct {
+			IngressClassName *string           `json:"ingressClassName,omitempty"`
+			DefaultBackend   *ingressBackendV1 `json:"defaultBackend,omitempty"`
+			TLS              []ingressTLSModel `json:"tls,omitempty"`
+			Rules            []struct {
+				Host string `json:"host,omitempty"`
+				HTTP *struct {
+					Paths []struct {
+						Path     string           `json:"path,omitempty"`
+						PathType *string          `json:"pathType,omitempty"`
+						Backend  ingressBackendV1 `json:"backend"`
+					} `json:"paths"`
+				} `json:"http,omitempty"`
+			} `json:"rules,omitempty"`
+		} `json:"spec,omitempty"`
+	} `json:"items"`
+}
+
+// ingressBackendV1 mirrors networking.k8s.io/v1 IngressBackend
+type ingressBackendV1 struct {
+	Service *struct {
+		Name string `json:"name"`
+		Port struct {
+			Name   string `json:"name,omitempty"`
+			Number int32  `json:"number,omitempty"`
+		} `json:"port,omitempty"`
+	} `json:"service,omitempty"`
+	Resource *v12.TypedLocalObjectReference `json:"resource,omitempty"`
+}
+
+// ingressV1beta1List mirrors networking.k8s.io/v1beta1 and extensions/v1beta1 IngressList including fields added after the vendored client-go release
+type ingressV1beta1List struct {
+	Items []ingressV1beta1 `json:"items"`
+}
+
+// ingressV1beta1 mirrors v1beta1 Ingress
+type ingressV1beta1 struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ingressSpecV1beta1 `json:"spec,omitempty"`
+}
+
+// ingressSpecV1beta1 mirrors v1beta1 IngressSpec
+type ingressSpecV1beta1 struct {
+	IngressClassName *string                `json:"ingressClassName,omitempty"`
+	Backend          *ingressBackendV1beta1 `json:"backend,omitempty"`
+	TLS              []ingressTLSModel      `json:"tls,omitempty"`
+	Rules            []ingressRuleV1beta1   `json:"rules,omitempty"`
+}
+
+// ingressRuleV1beta1 mirrors v1beta1 IngressRule
+type ingressRuleV1beta1 struct {
+	Host string              `json:"host,omitempty"`
+	HTTP *ingressHTTPV1beta1 `json:"http,omitempty"`
+}
+
+// ingressHTTPV1beta1 mirrors v1beta1 HTTPIngressRuleValue
+type ingressHTTPV1beta1 struct {
+	Paths []ingressPathV1beta1 `json:"paths"`
+}
+
+// ingressPathV1beta1 mirrors v1beta1 HTTPIngressPath
+type ingressPathV1beta1 struct {
+	Path     string                `json:"path,omitempty"`
+	PathType *string               `json:"pathType,omitempty"`
+	Backend  ingressBackendV1beta1 `json:"backend"`
+}
+
+// ingressBackendV1beta1 mirrors v1beta1 IngressBackend
+type ingressBackendV1beta1 struct {
+	ServiceName string                         `json:"serviceName,omitempty"`
+	ServicePort intstr.IntOrString             `json:"servicePort,omitempty"`
+	Resource    *v12.TypedLocalObjectReference `json:"resource,omitempty"`
+}
+
+// getIngresses obtains ingresses from a cluster using the most preferred served API version and converts them to the common model
+func getIngresses(clientSet kubernetes.Interface, namespace string) ([]ingressModel, error) {
+	groupVersion, err := common.GetServedGroupVersion(clientSet, "ingresses", ingressGroupVersions...)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("ingresses are obtained using '%s' API", groupVersion)
+
+	if groupVersion == ingressGroupVersionNetworkingV1 {
+		list := ingressV1List{}
+
+		if err := common.GetRawResourceList(clientSet, groupVersion, namespace, "ingresses", &list); err != nil {
+			return nil, fmt.Errorf("cannot obtain ingresses using '%s' API: %w", groupVersion, err)
+		}
+
+		return ingressModelsFromV1(&list, groupVersion), nil
+	}
+
+	list := ingressV1beta1List{}
+
+	if err := common.GetRawResourceList(clientSet, groupVersion, namespace, "ingresses", &list); err != nil {
+		return nil, fmt.Errorf("cannot obtain ingresses using '%s' API: %w", groupVersion, err)
+	}
+
+	return ingressModelsFromV1beta1(&list, groupVersion), nil
+}
+
+// ingressModelsFromV1 converts networking.k8s.io/v1 ingresses to the common model
+func ingressModelsFromV1(list *ingressV1List, groupVersion string) []ingressModel {
+	models := make([]ingressModel, 0, len(list.Items))
+
+	for _, item := range list.Items {
+		model := ingressModel{
+			Name:             item.Name,
+			APIVersion:       groupVersion,
+			Labels:           item.Labels,
+			Annotations:      item.Annotations,
+			IngressClassName: item.Spec.IngressClassName,
+			TLS:              item.Spec.TLS,
+		}
+
+		if item.Spec.DefaultBackend != nil {
+			backend := ingressBackendModelFromV1(*item.Spec.DefaultBackend)
+			model.DefaultBackend = &backend
+		}
+
+		for _, rule := range item.Spec.Rules {
+			ruleModel := ingressRuleModel{
+				Host: rule.Host,
+			}
+
+			if rule.HTTP != nil {
+				ruleModel.HTTP = &ingressHTTPModel{}
+
+				for _, path := range rule.HTTP.Paths {
+					ruleModel.HTTP.Paths = append(ruleModel.HTTP.Paths, ingressPathModel{
+						Path:     path.Path,
+						PathType: path.PathType,
+						Backend:  ingressBackendModelFromV1(path.Backend),
+					})
+				}
+			}
+
+			model.Rules = append(model.Rules, ruleModel)
+		}
+
+		models = append(models, model)
+	}
+
+	return models
+}
+
+// ingressBackendModelFromV1 converts networking.k8s.io/v1 ingress backend to the common model
+func ingressBackendModelFromV1(backend ingressBackendV1) ingressBackendModel {
+	model := ingressBackendModel{
+		Resource: backend.Resource,
+	}
+
+	if backend.Service != nil {
+		model.ServiceName = backend.Service.Name
+		model.ServicePortName = backend.Service.Port.Name
+		model.ServicePortNumber = backend.Service.Port.Number
+	}
+
+	return model
+}
+
+// ingressModelsFromV1beta1 converts networking.k8s.io/v1beta1 and extensions/v1beta1 ingresses to the common model
+func ingressModelsFromV1beta1(list *ingressV1beta1List, groupVersion string) []ingressModel {
+	models := make([]ingressModel, 0, len(list.Items))
+
+	for _, item := range list.Items {
+		model := ingressModel{
+			Name:             item.Name,
+			APIVersion:       groupVersion,
+			Labels:           item.Labels,
+			Annotations:      item.Annotations,
+			IngressClassName: item.Spec.IngressClassName,
+			TLS:              item.Spec.TLS,
+		}
+
+		if item.Spec.Backend != nil {
+			backend := ingressBackendModelFromV1beta1(*item.Spec.Backend)
+			model.DefaultBackend = &backend
+		}
+
+		for _, rule := range item.Spec.Rules {
+			ruleModel := ingressRuleModel{
+				Host: rule.Host,
+			}
+
+			if rule.HTTP != nil {
+				ruleModel.HTTP = &ingressHTTPModel{}
+
+				for _, path := range rule.HTTP.Paths {
+					ruleModel.HTTP.Paths = append(ruleModel.HTTP.Paths, ingressPathModel{
+						Path:     path.Path,
+						PathType: path.PathType,
+						Backend:  ingressBackendModelFromV1beta1(path.Backend),
+					})
+				}
+			}
+
+			model.Rules = append(model.Rules, ruleModel)
+		}
+
+		models = append(models, model)
+	}
+
+	return models
+}
+
+// ingressBackendModelFromV1beta1 converts v1beta1 ingress backend to the common model
+func ingressBackendModelFromV1beta1(backend ingressBackendV1beta1) ingressBackendModel {
+	model := ingressBackendModel{
+		ServiceName: backend.ServiceName,
+		Resource:    backend.Resource,
+	}
+
+	if backend.ServicePort.Type == intstr.Int {
+		model.ServicePortNumber = backend.ServicePort.IntVal
+	} else {
+		model.ServicePortName = backend.ServicePort.StrVal
+	}
+
+	return model
+}
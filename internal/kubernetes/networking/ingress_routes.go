@@ -0,0 +1,372 @@
+package networking
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+)
+
+// ingressRouteKey identifies a host/path route of an ingress
+type ingressRouteKey struct {
+	Host     string
+	Path     string
+	PathType string
+}
+
+// String returns a human-readable representation of the route
+func (k ingressRouteKey) String() string {
+	host := k.Host
+	if host == "" {
+		host = "*"
+	}
+
+	return fmt.Sprintf("%s%s (%s)", host, k.Path, k.PathType)
+}
+
+// ingressRouteSet is an order-insensitive representation of ingress rules. A route declared several times keeps all its backends
+type ingressRouteSet struct {
+	routes    map[ingressRouteKey][]ingressBackendModel
+	hosts     map[string]struct{}
+	httpHosts map[string]struct{}
+}
+
+// newIngressRouteSet collects routes of ingress rules
+func newIngressRouteSet(rules []ingressRuleModel) ingressRouteSet {
+	set := ingressRouteSet{
+		routes:    make(map[ingressRouteKey][]ingressBackendModel),
+		hosts:     make(map[string]struct{}),
+		httpHosts: make(map[string]struct{}),
+	}
+
+	for _, rule := range rules {
+		host := normalizeIngressHost(rule.Host)
+		set.hosts[host] = struct{}{}
+
+		if rule.HTTP == nil {
+			continue
+		}
+
+		set.httpHosts[host] = struct{}{}
+
+		for _, path := range rule.HTTP.Paths {
+			key := ingressRouteKey{Host: host, Path: path.Path, PathType: getIngressPathType(path)}
+			set.routes[key] = append(set.routes[key], path.Backend)
+		}
+	}
+
+	for key := range set.routes {
+		sortIngressBackends(set.routes[key])
+	}
+
+	return set
+}
+
+// sortedKeys returns route keys of both sets in a stable order
+func (s ingressRouteSet) sortedKeys(other ingressRouteSet) []ingressRouteKey {
+	keys := make([]ingressRouteKey, 0, len(s.routes)+len(other.routes))
+	for key := range s.routes {
+		keys = append(keys, key)
+	}
+	for key := range other.routes {
+		if _, ok := s.routes[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+
+	return keys
+}
+
+// compareIngressTLS compares TLS blocks of ingresses keyed by secret name regardless of their order
+func compareIngressTLS(name string, tls1, tls2 []ingressTLSModel) error {
+	if tls1 == nil || tls2 == nil {
+		if tls1 != nil || tls2 != nil {
+			return fmt.Errorf("%w. Name ingress: '%s'", ErrorTLSInIngressesDifferent, name)
+		}
+		return nil
+	}
+
+	groups1, groups2 := groupIngressTLS(tls1), groupIngressTLS(tls2)
+
+	secretsOnly1, secretsOnly2 := diffStringSets(ingressTLSSecrets(groups1), ingressTLSSecrets(groups2))
+	if len(groups1) != len(groups2) {
+		return fmt.Errorf("%w. Name ingress: '%s'. In first ingress - %d TLS. In second ingress - %d TLS. Secrets only in first ingress: %v. Secrets only in second ingress: %v", ErrorTLSCountDifferent, name, len(groups1), len(groups2), secretsOnly1, secretsOnly2)
+	}
+	if len(secretsOnly1) > 0 || len(secretsOnly2) > 0 {
+		return fmt.Errorf("%w. Name ingress: '%s'. First ingress: '%s'. Second ingress: '%s'", ErrorSecretNameInTLSDifferent, name, strings.Join(secretsOnly1, "', '"), strings.Join(secretsOnly2, "', '"))
+	}
+
+	secrets := make([]string, 0, len(groups1))
+	for secret := range groups1 {
+		secrets = append(secrets, secret)
+	}
+	sort.Strings(secrets)
+
+	for _, secret := range secrets {
+		hosts1, hosts2 := groups1[secret], groups2[secret]
+
+		if (hosts1 == nil) != (hosts2 == nil) {
+			return fmt.Errorf("%w. Name ingress: '%s'. Secret '%s'", ErrorHostsInIngressesDifferent, name, secret)
+		}
+		if len(hosts1) != len(hosts2) {
+			return fmt.Errorf("%w. Name ingress: '%s'. Secret '%s'. In first ingress - %d hosts. In second ingress - %d hosts. %s", ErrorHostsCountDifferent, name, secret, len(hosts1), len(hosts2), describeTLSHostDifferences(hosts1, hosts2))
+		}
+
+		hostsOnly1, hostsOnly2 := diffStringSets(hosts1, hosts2)
+		if len(hostsOnly1) > 0 || len(hostsOnly2) > 0 {
+			return fmt.Errorf("%w. Name ingress: '%s'. Secret '%s'. %s", ErrorNameHostDifferent, name, secret, describeTLSHostDifferences(hosts1, hosts2))
+		}
+	}
+
+	return nil
+}
+
+// groupIngressTLS merges TLS blocks by secret name. Blocks without hosts yield a nil host set
+func groupIngressTLS(tls []ingressTLSModel) map[string]map[string]struct{} {
+	groups := make(map[string]map[string]struct{}, len(tls))
+
+	for _, block := range tls {
+		hosts, ok := groups[block.SecretName]
+		if !ok {
+			groups[block.SecretName] = nil
+		}
+
+		if block.Hosts == nil {
+			continue
+		}
+
+		if hosts == nil {
+			hosts = make(map[string]struct{}, len(block.Hosts))
+			groups[block.SecretName] = hosts
+		}
+		for _, host := range block.Hosts {
+			hosts[normalizeIngressHost(host)] = struct{}{}
+		}
+	}
+
+	return groups
+}
+
+// describeTLSHostDifferences lists TLS hosts present in one ingress only, noting those still covered by a wildcard host of the other ingress
+func describeTLSHostDifferences(hosts1, hosts2 map[string]struct{}) string {
+	hostsOnly1, hostsOnly2 := diffStringSets(hosts1, hosts2)
+
+	return fmt.Sprintf("Hosts only in first ingress: [%s]. Hosts only in second ingress: [%s]", describeHostsCoverage(hostsOnly1, hosts2), describeHostsCoverage(hostsOnly2, hosts1))
+}
+
+// describeHostsCoverage formats hosts adding the wildcard host of the other ingress covering each of them
+func describeHostsCoverage(hosts []string, other map[string]struct{}) string {
+	described := make([]string, 0, len(hosts))
+
+	for _, host := range hosts {
+		if wildcard, ok := findCoveringWildcard(host, other); ok {
+			described = append(described, fmt.Sprintf("'%s' (covered by '%s')", host, wildcard))
+			continue
+		}
+		described = append(described, fmt.Sprintf("'%s'", host))
+	}
+
+	return strings.Join(described, ", ")
+}
+
+// compareIngressRules compares ingress rules keyed by host and their paths keyed by path and path type regardless of their order
+func compareIngressRules(name string, rules1, rules2 []ingressRuleModel) error { //nolint:gocyclo
+	if rules1 == nil || rules2 == nil {
+		if rules1 != nil || rules2 != nil {
+			return fmt.Errorf("%w. Name ingress: '%s'", ErrorRulesInIngressesDifferent, name)
+		}
+		return nil
+	}
+
+	set1, set2 := newIngressRouteSet(rules1), newIngressRouteSet(rules2)
+	routeDiffs := describeIngressRouteDifferences(set1, set2)
+	hostsOnly1, hostsOnly2 := diffStringSets(set1.hosts, set2.hosts)
+	httpHostsOnly1, httpHostsOnly2 := diffStringSets(set1.httpHosts, set2.httpHosts)
+
+	var (
+		reason error
+		detail string
+	)
+
+	switch {
+	case len(hostsOnly1) > 0 || len(hostsOnly2) > 0:
+		reason = ErrorHostNameInRuleDifferent
+		detail = fmt.Sprintf("Hosts only in first ingress: %v. Hosts only in second ingress: %v", hostsOnly1, hostsOnly2)
+
+	case len(httpHostsOnly1) > 0 || len(httpHostsOnly2) > 0:
+		reason = ErrorHTTPInIngressesDifferent
+		detail = fmt.Sprintf("Hosts with HTTP paths only in first ingress: %v. Hosts with HTTP paths only in second ingress: %v", httpHostsOnly1, httpHostsOnly2)
+
+	default:
+		reason = compareIngressRouteSets(set1, set2)
+	}
+
+	if reason == nil {
+		return nil
+	}
+
+	details := make([]string, 0, len(routeDiffs)+1)
+	if detail != "" {
+		details = append(details, detail)
+	}
+	details = append(details, routeDiffs...)
+
+	return fmt.Errorf("%w. Name ingress: '%s'. %s", reason, name, strings.Join(details, ". "))
+}
+
+// compareIngressRouteSets returns the reason two route sets with matching hosts differ
+func compareIngressRouteSets(set1, set2 ingressRouteSet) error {
+	var backendReason error
+
+	for _, key := range set1.sortedKeys(set2) {
+		backends1, backends2 := set1.routes[key], set2.routes[key]
+
+		if len(backends1) != len(backends2) {
+			if set1.hasPathWithOtherType(key) || set2.hasPathWithOtherType(key) {
+				return ErrorPathTypeDifferent
+			}
+			return ErrorPathValueDifferent
+		}
+
+		if backendReason != nil {
+			continue
+		}
+		for i := range backends1 {
+			if reason := getIngressBackendDifference(backends1[i], backends2[i]); reason != nil {
+				backendReason = reason
+				break
+			}
+		}
+	}
+
+	return backendReason
+}
+
+// hasPathWithOtherType reports whether the set has the route path with a path type different from the given one
+func (s ingressRouteSet) hasPathWithOtherType(key ingressRouteKey) bool {
+	for other := range s.routes {
+		if other.Host == key.Host && other.Path == key.Path && other.PathType != key.PathType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// describeIngressRouteDifferences lists routes missing in one of the ingresses or routed to different backends
+func describeIngressRouteDifferences(set1, set2 ingressRouteSet) []string {
+	var diffs []string
+
+	for _, key := range set1.sortedKeys(set2) {
+		backends1, ok1 := set1.routes[key]
+		backends2, ok2 := set2.routes[key]
+
+		switch {
+		case !ok2:
+			diffs = append(diffs, fmt.Sprintf("Route '%s' is missing in second ingress%s", key, describeRouteCoverage(key, set2)))
+		case !ok1:
+			diffs = append(diffs, fmt.Sprintf("Route '%s' is missing in first ingress%s", key, describeRouteCoverage(key, set1)))
+		case !reflect.DeepEqual(backends1, backends2):
+			diffs = append(diffs, fmt.Sprintf("Route '%s' is routed to %s in first ingress and to %s in second ingress", key, formatIngressBackends(backends1), formatIngressBackends(backends2)))
+		}
+	}
+
+	return diffs
+}
+
+// describeRouteCoverage notes the wildcard route of the other ingress still matching the missing route
+func describeRouteCoverage(key ingressRouteKey, other ingressRouteSet) string {
+	wildcard, ok := findCoveringWildcard(key.Host, other.hosts)
+	if !ok {
+		return ""
+	}
+
+	if _, ok := other.routes[ingressRouteKey{Host: wildcard, Path: key.Path, PathType: key.PathType}]; !ok {
+		return ""
+	}
+
+	return fmt.Sprintf(" (matched by wildcard host '%s' there)", wildcard)
+}
+
+// getIngressBackendDifference returns the reason the backends differ or nil
+func getIngressBackendDifference(backend1, backend2 ingressBackendModel) error {
+	if backend1.ServiceName != backend2.ServiceName {
+		return ErrorServiceNameInBackendDifferent
+	}
+	if backend1.ServicePortName != backend2.ServicePortName || backend1.ServicePortNumber != backend2.ServicePortNumber {
+		return ErrorBackendServicePortDifferent
+	}
+	if !reflect.DeepEqual(backend1.Resource, backend2.Resource) {
+		return ErrorResourceBackendDifferent
+	}
+
+	return nil
+}
+
+// formatIngressBackend returns a human-readable representation of the backend
+func formatIngressBackend(backend ingressBackendModel) string {
+	if backend.Resource != nil {
+		return fmt.Sprintf("%s/%s", backend.Resource.Kind, backend.Resource.Name)
+	}
+	if backend.ServicePortName != "" {
+		return fmt.Sprintf("%s:%s", backend.ServiceName, backend.ServicePortName)
+	}
+
+	return fmt.Sprintf("%s:%d", backend.ServiceName, backend.ServicePortNumber)
+}
+
+// formatIngressBackends returns a human-readable representation of the backends of a route
+func formatIngressBackends(backends []ingressBackendModel) string {
+	formatted := make([]string, 0, len(backends))
+	for _, backend := range backends {
+		formatted = append(formatted, fmt.Sprintf("'%s'", formatIngressBackend(backend)))
+	}
+
+	return strings.Join(formatted, ", ")
+}
+
+// sortIngressBackends orders backends of a route declared several times
+func sortIngressBackends(backends []ingressBackendModel) {
+	sort.SliceStable(backends, func(i, j int) bool {
+		return formatIngressBackend(backends[i]) < formatIngressBackend(backends[j])
+	})
+}
+
+// normalizeIngressHost lower-cases the host as host names are case-insensitive
+func normalizeIngressHost(host string) string {
+	return strings.ToLower(host)
+}
+
+// findCoveringWildcard returns the wildcard host (e.g. '*.example.com') of the set matching the host. A wildcard matches a single DNS label only
+func findCoveringWildcard(host string, hosts map[string]struct{}) (string, bool) {
+	dot := strings.Index(host, ".")
+	if dot <= 0 || strings.HasPrefix(host, "*.") {
+		return "", false
+	}
+
+	wildcard := "*" + host[dot:]
+	_, ok := hosts[wildcard]
+
+	return wildcard, ok
+}
+
+// diffStringSets returns sorted elements present only in the first and only in the second set
+func diffStringSets(set1, set2 map[string]struct{}) ([]string, []string) {
+	return common.SubtractStringSets(set1, set2), common.SubtractStringSets(set2, set1)
+}
+
+// ingressTLSSecrets returns secret names of TLS blocks grouped by groupIngressTLS
+func ingressTLSSecrets(groups map[string]map[string]struct{}) map[string]struct{} {
+	secrets := make(map[string]struct{}, len(groups))
+	for secret := range groups {
+		secrets[secret] = struct{}{}
+	}
+
+	return secrets
+}
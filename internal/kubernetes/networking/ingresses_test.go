@@ -1,7 +1,9 @@
 package networking
 
 import (
+	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
 
 	"k8s.io/api/networking/v1beta1"
@@ -16,6 +18,53 @@ var (
 	clusterClientSet2 *fake.Clientset
 )
 
+// toIngressModel converts a typed ingress to the common model the same way as ingresses obtained from a cluster
+func toIngressModel(t *testing.T, ingress *v1beta1.Ingress) ingressModel {
+	t.Helper()
+
+	item := ingressV1beta1{
+		ObjectMeta: ingress.ObjectMeta,
+	}
+
+	if ingress.Spec.Backend != nil {
+		item.Spec.Backend = &ingressBackendV1beta1{
+			ServiceName: ingress.Spec.Backend.ServiceName,
+			ServicePort: ingress.Spec.Backend.ServicePort,
+		}
+	}
+
+	for _, tls := range ingress.Spec.TLS {
+		item.Spec.TLS = append(item.Spec.TLS, ingressTLSModel{
+			Hosts:      tls.Hosts,
+			SecretName: tls.SecretName,
+		})
+	}
+
+	for _, rule := range ingress.Spec.Rules {
+		ruleV1beta1 := ingressRuleV1beta1{
+			Host: rule.Host,
+		}
+
+		if rule.HTTP != nil {
+			ruleV1beta1.HTTP = &ingressHTTPV1beta1{}
+
+			for _, path := range rule.HTTP.Paths {
+				ruleV1beta1.HTTP.Paths = append(ruleV1beta1.HTTP.Paths, ingressPathV1beta1{
+					Path: path.Path,
+					Backend: ingressBackendV1beta1{
+						ServiceName: path.Backend.ServiceName,
+						ServicePort: path.Backend.ServicePort,
+					},
+				})
+			}
+		}
+
+		item.Spec.Rules = append(item.Spec.Rules, ruleV1beta1)
+	}
+
+	return ingressModelsFromV1beta1(&ingressV1beta1List{Items: []ingressV1beta1{item}}, ingressGroupVersionNetworkingV1beta1)[0]
+}
+
 func initEnvironmentForFirthTest4() {
 	clusterClientSet1 = fake.NewSimpleClientset(&v1beta1.Ingress{
 		ObjectMeta: metav1.ObjectMeta{
@@ -741,7 +790,7 @@ func TestCompareSpecInIngresses(t *testing.T) {
 	initEnvironmentForFirthTest4()
 	ingress1, _ := clusterClientSet1.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
 	ingress2, _ := clusterClientSet2.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
-	err := compareSpecInIngresses(*ingress1, *ingress2)
+	err := compareSpecInIngresses(toIngressModel(t, ingress1), toIngressModel(t, ingress2))
 	if !errors.Is(errors.Unwrap(err), ErrorTLSInIngressesDifferent) {
 		t.Error("the TLS in the ingresses are different'. But it was returned: ", err)
 	}
@@ -749,7 +798,7 @@ func TestCompareSpecInIngresses(t *testing.T) {
 	initEnvironmentForSecondTest4()
 	ingress1, _ = clusterClientSet1.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
 	ingress2, _ = clusterClientSet2.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
-	err = compareSpecInIngresses(*ingress1, *ingress2)
+	err = compareSpecInIngresses(toIngressModel(t, ingress1), toIngressModel(t, ingress2))
 	if !errors.Is(errors.Unwrap(err), ErrorTLSCountDifferent) {
 		t.Error("the TLS count in the ingresses are different'. But it was returned: ", err)
 	}
@@ -757,7 +806,7 @@ func TestCompareSpecInIngresses(t *testing.T) {
 	initEnvironmentForThirdTest4()
 	ingress1, _ = clusterClientSet1.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
 	ingress2, _ = clusterClientSet2.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
-	err = compareSpecInIngresses(*ingress1, *ingress2)
+	err = compareSpecInIngresses(toIngressModel(t, ingress1), toIngressModel(t, ingress2))
 	if !errors.Is(errors.Unwrap(err), ErrorSecretNameInTLSDifferent) {
 		t.Error("the secret name in the TLS are different'. But it was returned: ", err)
 	}
@@ -765,7 +814,7 @@ func TestCompareSpecInIngresses(t *testing.T) {
 	initEnvironmentForFifthTest4()
 	ingress1, _ = clusterClientSet1.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
 	ingress2, _ = clusterClientSet2.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
-	err = compareSpecInIngresses(*ingress1, *ingress2)
+	err = compareSpecInIngresses(toIngressModel(t, ingress1), toIngressModel(t, ingress2))
 	if !errors.Is(errors.Unwrap(err), ErrorHostsCountDifferent) {
 		t.Error("the hosts count in the TLS are different'. But it was returned: ", err)
 	}
@@ -773,7 +822,7 @@ func TestCompareSpecInIngresses(t *testing.T) {
 	initEnvironmentForSixthTest4()
 	ingress1, _ = clusterClientSet1.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
 	ingress2, _ = clusterClientSet2.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
-	err = compareSpecInIngresses(*ingress1, *ingress2)
+	err = compareSpecInIngresses(toIngressModel(t, ingress1), toIngressModel(t, ingress2))
 	if !errors.Is(errors.Unwrap(err), ErrorNameHostDifferent) {
 		t.Error("the name host in the TLS are different'. But it was returned: ", err)
 	}
@@ -781,7 +830,7 @@ func TestCompareSpecInIngresses(t *testing.T) {
 	initEnvironmentForSeventhTest4()
 	ingress1, _ = clusterClientSet1.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
 	ingress2, _ = clusterClientSet2.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
-	err = compareSpecInIngresses(*ingress1, *ingress2)
+	err = compareSpecInIngresses(toIngressModel(t, ingress1), toIngressModel(t, ingress2))
 	if !errors.Is(errors.Unwrap(err), ErrorHostsInIngressesDifferent) {
 		t.Error("the hosts in the ingresses are different'. But it was returned: ", err)
 	}
@@ -789,7 +838,7 @@ func TestCompareSpecInIngresses(t *testing.T) {
 	initEnvironmentForEighthTest4()
 	ingress1, _ = clusterClientSet1.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
 	ingress2, _ = clusterClientSet2.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
-	err = compareSpecInIngresses(*ingress1, *ingress2)
+	err = compareSpecInIngresses(toIngressModel(t, ingress1), toIngressModel(t, ingress2))
 	if !errors.Is(errors.Unwrap(err), ErrorBackendInIngressesDifferent) {
 		t.Error("the backend in the ingresses are different'. But it was returned: ", err)
 	}
@@ -797,7 +846,7 @@ func TestCompareSpecInIngresses(t *testing.T) {
 	initEnvironmentForNinthTest4()
 	ingress1, _ = clusterClientSet1.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
 	ingress2, _ = clusterClientSet2.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
-	err = compareSpecInIngresses(*ingress1, *ingress2)
+	err = compareSpecInIngresses(toIngressModel(t, ingress1), toIngressModel(t, ingress2))
 	if !errors.Is(errors.Unwrap(err), ErrorServiceNameInBackendDifferent) {
 		t.Error("the service name in the backend are different'. But it was returned: ", err)
 	}
@@ -805,7 +854,7 @@ func TestCompareSpecInIngresses(t *testing.T) {
 	initEnvironmentForTenthTest4()
 	ingress1, _ = clusterClientSet1.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
 	ingress2, _ = clusterClientSet2.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
-	err = compareSpecInIngresses(*ingress1, *ingress2)
+	err = compareSpecInIngresses(toIngressModel(t, ingress1), toIngressModel(t, ingress2))
 	if !errors.Is(errors.Unwrap(err), ErrorBackendServicePortDifferent) {
 		t.Error("the service port in the backend are different'. But it was returned: ", err)
 	}
@@ -813,7 +862,7 @@ func TestCompareSpecInIngresses(t *testing.T) {
 	initEnvironmentForEleventhTest4()
 	ingress1, _ = clusterClientSet1.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
 	ingress2, _ = clusterClientSet2.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
-	err = compareSpecInIngresses(*ingress1, *ingress2)
+	err = compareSpecInIngresses(toIngressModel(t, ingress1), toIngressModel(t, ingress2))
 	if !errors.Is(errors.Unwrap(err), ErrorRulesInIngressesDifferent) {
 		t.Error("the rules in the ingresses are different'. But it was returned: ", err)
 	}
@@ -821,15 +870,15 @@ func TestCompareSpecInIngresses(t *testing.T) {
 	initEnvironmentForTwelvesTest4()
 	ingress1, _ = clusterClientSet1.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
 	ingress2, _ = clusterClientSet2.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
-	err = compareSpecInIngresses(*ingress1, *ingress2)
-	if !errors.Is(errors.Unwrap(err), ErrorRulesCountDifferent) {
-		t.Error("the rules count in the ingresses is different'. But it was returned: ", err)
+	err = compareSpecInIngresses(toIngressModel(t, ingress1), toIngressModel(t, ingress2))
+	if !errors.Is(errors.Unwrap(err), ErrorPathValueDifferent) {
+		t.Error("the path value in the ingresses is different'. But it was returned: ", err)
 	}
 
 	initEnvironmentForThirteenthTest4()
 	ingress1, _ = clusterClientSet1.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
 	ingress2, _ = clusterClientSet2.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
-	err = compareSpecInIngresses(*ingress1, *ingress2)
+	err = compareSpecInIngresses(toIngressModel(t, ingress1), toIngressModel(t, ingress2))
 	if !errors.Is(errors.Unwrap(err), ErrorHostNameInRuleDifferent) {
 		t.Error("the hosts name in the rule are different'. But it was returned: ", err)
 	}
@@ -837,7 +886,7 @@ func TestCompareSpecInIngresses(t *testing.T) {
 	initEnvironmentForFourteenthTest4()
 	ingress1, _ = clusterClientSet1.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
 	ingress2, _ = clusterClientSet2.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
-	err = compareSpecInIngresses(*ingress1, *ingress2)
+	err = compareSpecInIngresses(toIngressModel(t, ingress1), toIngressModel(t, ingress2))
 	if !errors.Is(errors.Unwrap(err), ErrorHTTPInIngressesDifferent) {
 		t.Error("the HTTP in the ingresses is different'. But it was returned: ", err)
 	}
@@ -845,16 +894,113 @@ func TestCompareSpecInIngresses(t *testing.T) {
 	initEnvironmentForFifteenthTest4()
 	ingress1, _ = clusterClientSet1.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
 	ingress2, _ = clusterClientSet2.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
-	err = compareSpecInIngresses(*ingress1, *ingress2)
-	if !errors.Is(errors.Unwrap(err), ErrorPathsCountDifferent) {
-		t.Error("the paths count in the ingresses is different'. But it was returned: ", err)
+	err = compareSpecInIngresses(toIngressModel(t, ingress1), toIngressModel(t, ingress2))
+	if !errors.Is(errors.Unwrap(err), ErrorPathValueDifferent) {
+		t.Error("the path value in the ingresses is different'. But it was returned: ", err)
 	}
 
 	initEnvironmentForSixteenthTest4()
 	ingress1, _ = clusterClientSet1.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
 	ingress2, _ = clusterClientSet2.NetworkingV1beta1().Ingresses("default").Get("testIngress", metav1.GetOptions{})
-	err = compareSpecInIngresses(*ingress1, *ingress2)
+	err = compareSpecInIngresses(toIngressModel(t, ingress1), toIngressModel(t, ingress2))
 	if !errors.Is(errors.Unwrap(err), ErrorPathValueDifferent) {
 		t.Error("the path value in the ingresses is different'. But it was returned: ", err)
 	}
 }
+
+func TestCompareIngressesOfDifferentVersions(t *testing.T) {
+	var (
+		ingressesV1      = ingressV1List{}
+		ingressesV1beta1 = ingressV1beta1List{}
+	)
+
+	err := json.Unmarshal([]byte(`{"items":[{"metadata":{"name":"testIngress"},"spec":{
+		"defaultBackend":{"service":{"name":"default","port":{"number":80}}},
+		"rules":[{"host":"example.com","http":{"paths":[{"path":"/","pathType":"ImplementationSpecific","backend":{"service":{"name":"web","port":{"name":"http"}}}}]}}]}}]}`), &ingressesV1)
+	if err != nil {
+		t.Fatalf("cannot unmarshal ingress: %s", err.Error())
+	}
+
+	err = json.Unmarshal([]byte(`{"items":[{"metadata":{"name":"testIngress"},"spec":{
+		"backend":{"serviceName":"default","servicePort":80},
+		"rules":[{"host":"example.com","http":{"paths":[{"path":"/","backend":{"serviceName":"web","servicePort":"http"}}]}}]}}]}`), &ingressesV1beta1)
+	if err != nil {
+		t.Fatalf("cannot unmarshal ingress: %s", err.Error())
+	}
+
+	ingress1 := ingressModelsFromV1(&ingressesV1, ingressGroupVersionNetworkingV1)[0]
+	ingress2 := ingressModelsFromV1beta1(&ingressesV1beta1, ingressGroupVersionExtensionsV1beta1)[0]
+
+	if err := compareSpecInIngresses(ingress1, ingress2); err != nil {
+		t.Error("Equal ingresses obtained using different API versions are reported as different: ", err)
+	}
+
+	pathType := "Prefix"
+	ingress1.Rules[0].HTTP.Paths[0].PathType = &pathType
+
+	if err := compareSpecInIngresses(ingress1, ingress2); !errors.Is(errors.Unwrap(err), ErrorPathTypeDifferent) {
+		t.Error("Error expected: 'the path type in the ingresses is different'. But it was returned: ", err)
+	}
+}
+
+func TestCompareIngressesRegardlessOfOrder(t *testing.T) {
+	backend := func(service string, port int32) ingressBackendModel {
+		return ingressBackendModel{ServiceName: service, ServicePortNumber: port}
+	}
+
+	ingress1 := ingressModel{
+		Name: "testIngress",
+		TLS: []ingressTLSModel{
+			{SecretName: "first", Hosts: []string{"a.example.com", "b.example.com"}},
+			{SecretName: "second", Hosts: []string{"c.example.com"}},
+		},
+		Rules: []ingressRuleModel{
+			{Host: "a.example.com", HTTP: &ingressHTTPModel{Paths: []ingressPathModel{
+				{Path: "/api", Backend: backend("api", 80)},
+				{Path: "/", Backend: backend("web", 80)},
+			}}},
+			{Host: "b.example.com", HTTP: &ingressHTTPModel{Paths: []ingressPathModel{
+				{Path: "/", Backend: backend("web", 80)},
+			}}},
+		},
+	}
+
+	ingress2 := ingressModel{
+		Name: "testIngress",
+		TLS: []ingressTLSModel{
+			{SecretName: "second", Hosts: []string{"c.example.com"}},
+			{SecretName: "first", Hosts: []string{"B.example.com", "a.example.com"}},
+		},
+		Rules: []ingressRuleModel{
+			{Host: "b.example.com", HTTP: &ingressHTTPModel{Paths: []ingressPathModel{
+				{Path: "/", Backend: backend("web", 80)},
+			}}},
+			{Host: "a.example.com", HTTP: &ingressHTTPModel{Paths: []ingressPathModel{
+				{Path: "/", Backend: backend("web", 80)},
+				{Path: "/api", Backend: backend("api", 80)},
+			}}},
+		},
+	}
+
+	if err := compareSpecInIngresses(ingress1, ingress2); err != nil {
+		t.Error("Ingresses differing in the order of TLS, rules and paths only are reported as different: ", err)
+	}
+
+	ingress2.Rules[1].HTTP.Paths[1].Backend = backend("api-v2", 80)
+
+	err := compareSpecInIngresses(ingress1, ingress2)
+	if !errors.Is(errors.Unwrap(err), ErrorServiceNameInBackendDifferent) {
+		t.Error("Error expected: 'the service name in the backend are different'. But it was returned: ", err)
+	} else if !strings.Contains(err.Error(), "'a.example.com/api (ImplementationSpecific)' is routed to 'api:80' in first ingress and to 'api-v2:80' in second ingress") {
+		t.Error("The misrouted route is not reported: ", err)
+	}
+
+	ingress2.TLS[1].Hosts = []string{"*.example.com", "a.example.com"}
+
+	err = compareSpecInIngresses(ingress1, ingress2)
+	if !errors.Is(errors.Unwrap(err), ErrorNameHostDifferent) {
+		t.Error("Error expected: 'the name host in the TLS are different'. But it was returned: ", err)
+	} else if !strings.Contains(err.Error(), "'b.example.com' (covered by '*.example.com')") {
+		t.Error("The host covered by the wildcard host is not reported: ", err)
+	}
+}
@@ -5,14 +5,20 @@ import (
 
 	"go.uber.org/zap"
 
+	"k8s-cluster-comparator/internal/config"
 	"k8s-cluster-comparator/internal/logging"
 )
 
 var (
 	log *zap.SugaredLogger
+
+	compareAllocatedValues bool
 )
 
 func Init(ctx context.Context) error {
 	log = logging.FromContext(ctx)
+
+	compareAllocatedValues = config.FromContext(ctx).CompareAllocatedValues
+
 	return nil
 }
@@ -0,0 +1,194 @@
+package networking
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+	"k8s-cluster-comparator/internal/kubernetes/kv_maps"
+	"k8s-cluster-comparator/internal/kubernetes/skipper"
+	"k8s-cluster-comparator/internal/kubernetes/types"
+)
+
+// CompareNetworkPolicies compares list of network policies objects in two given k8s-clusters
+func CompareNetworkPolicies(clientSet1, clientSet2 kubernetes.Interface, namespace string, skipEntityList skipper.SkipEntitiesList) (bool, error) {
+	policies1, err := getNetworkPolicies(clientSet1, namespace)
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain network policies from 1st cluster: %w", err)
+	}
+
+	policies2, err := getNetworkPolicies(clientSet2, namespace)
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain network policies from 2nd cluster: %w", err)
+	}
+
+	mapPolicies1, mapPolicies2 := prepareNetworkPolicyMaps(policies1, policies2, skipEntityList.GetByKind("networkpolicies"))
+
+	return setInformationAboutNetworkPolicies(mapPolicies1, mapPolicies2, policies1, policies2), nil
+}
+
+// getNetworkPolicies obtains network policies of the namespace and normalizes them, named ports are resolved using
+// pods of the namespace
+func getNetworkPolicies(clientSet kubernetes.Interface, namespace string) ([]networkPolicyModel, error) {
+	policies, err := clientSet.NetworkingV1().NetworkPolicies(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot obtain network policies list: %w", err)
+	}
+
+	if len(policies.Items) == 0 {
+		return nil, nil
+	}
+
+	pods, err := clientSet.CoreV1().Pods(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot obtain pods list: %w", err)
+	}
+
+	models := make([]networkPolicyModel, 0, len(policies.Items))
+	for _, policy := range policies.Items {
+		models = append(models, newNetworkPolicyModel(policy, pods.Items))
+	}
+
+	return models, nil
+}
+
+// prepareNetworkPolicyMaps add value network policies in map
+func prepareNetworkPolicyMaps(policies1, policies2 []networkPolicyModel, skipEntities skipper.SkipComponentNames) (map[string]types.IsAlreadyComparedFlag, map[string]types.IsAlreadyComparedFlag) { //nolint:gocritic,unused
+	mapPolicies1 := make(map[string]types.IsAlreadyComparedFlag)
+	mapPolicies2 := make(map[string]types.IsAlreadyComparedFlag)
+	var indexCheck types.IsAlreadyComparedFlag
+
+	for index, value := range policies1 {
+		if skipEntities.IsSkippedEntity(value.Name) {
+			log.Debugf("network policy %s is skipped from comparison due to its name", value.Name)
+			continue
+		}
+		indexCheck.Index = index
+		mapPolicies1[value.Name] = indexCheck
+	}
+	for index, value := range policies2 {
+		if skipEntities.IsSkippedEntity(value.Name) {
+			log.Debugf("network policy %s is skipped from comparison due to its name", value.Name)
+			continue
+		}
+		indexCheck.Index = index
+		mapPolicies2[value.Name] = indexCheck
+	}
+
+	return mapPolicies1, mapPolicies2
+}
+
+// setInformationAboutNetworkPolicies set information about network policies
+func setInformationAboutNetworkPolicies(map1, map2 map[string]types.IsAlreadyComparedFlag, policies1, policies2 []networkPolicyModel) bool {
+	var (
+		flag bool
+	)
+
+	if len(map1) != len(map2) {
+		log.Infof("network policy counts are different")
+		flag = true
+	}
+
+	wg := &sync.WaitGroup{}
+	channel := make(chan bool, len(map1))
+
+	for name, index1 := range map1 {
+		if index2, ok := map2[name]; ok {
+			wg.Add(1)
+
+			index1.Check = true
+			map1[name] = index1
+			index2.Check = true
+			map2[name] = index2
+
+			go compareNetworkPolicySpecInternals(wg, channel, name, &policies1[index1.Index], &policies2[index2.Index])
+		} else {
+			log.Infof("network policy '%s' does not exist in 2nd cluster", name)
+			flag = true
+			channel <- flag
+		}
+	}
+
+	wg.Wait()
+
+	close(channel)
+
+	for ch := range channel {
+		if ch {
+			flag = true
+		}
+	}
+	for name, index := range map2 {
+		if !index.Check {
+			log.Infof("network policy '%s' does not exist in 1st cluster", name)
+			flag = true
+		}
+	}
+
+	return flag
+}
+
+func compareNetworkPolicySpecInternals(wg *sync.WaitGroup, channel chan bool, name string, policy1, policy2 *networkPolicyModel) {
+	var (
+		flag bool
+	)
+	defer func() {
+		wg.Done()
+	}()
+
+	log.Debugf("----- Start checking network policy: '%s' -----", name)
+
+	if !kv_maps.AreKVMapsEqual(policy1.Labels, policy2.Labels, common.SkippedKubeLabels) {
+		log.Infof("metadata of network policy '%s' differs: different labels", policy1.Name)
+		channel <- true
+		return
+	}
+
+	if !kv_maps.AreKVMapsEqual(policy1.Annotations, policy2.Annotations, nil) {
+		log.Infof("metadata of network policy '%s' differs: different annotations", policy1.Name)
+		channel <- true
+		return
+	}
+
+	err := compareSpecInNetworkPolicies(*policy1, *policy2)
+	if err != nil {
+		log.Infof("NetworkPolicy %s: %s", name, err.Error())
+		flag = true
+	}
+
+	log.Debugf("----- End checking network policy: '%s' -----", name)
+	channel <- flag
+}
+
+// compareSpecInNetworkPolicies compares the selected pods, enforced policy types and allowed traffic of network policies
+func compareSpecInNetworkPolicies(policy1, policy2 networkPolicyModel) error {
+	if policy1.PodSelector != policy2.PodSelector {
+		return fmt.Errorf("%w. First policy: '%s'. Second policy: '%s'", ErrorPodSelectorInPoliciesDifferent, policy1.PodSelector, policy2.PodSelector)
+	}
+
+	types1, types2 := strings.Join(policy1.PolicyTypes, ", "), strings.Join(policy2.PolicyTypes, ", ")
+	if types1 != types2 {
+		return fmt.Errorf("%w. First policy: [%s]. Second policy: [%s]", ErrorPolicyTypesDifferent, types1, types2)
+	}
+
+	if err := comparePolicyTuples(ErrorIngressRulesDifferent, policy1.Ingress, policy2.Ingress); err != nil {
+		return err
+	}
+
+	return comparePolicyTuples(ErrorEgressRulesDifferent, policy1.Egress, policy2.Egress)
+}
+
+// comparePolicyTuples reports traffic allowed by the policy in one cluster only
+func comparePolicyTuples(reason error, tuples1, tuples2 map[string]struct{}) error {
+	onlyIn1, onlyIn2 := diffStringSets(tuples1, tuples2)
+
+	if len(onlyIn1) == 0 && len(onlyIn2) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%w. Only in 1st cluster: [%s]. Only in 2nd cluster: [%s]", reason, strings.Join(onlyIn1, "; "), strings.Join(onlyIn2, "; "))
+}
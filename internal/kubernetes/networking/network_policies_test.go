@@ -0,0 +1,111 @@
+package networking
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	v12 "k8s.io/api/core/v1"
+	v1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s-cluster-comparator/internal/logging"
+)
+
+func newTestNetworkPolicy(ingress []v1.NetworkPolicyIngressRule, egress []v1.NetworkPolicyEgressRule) *v1.NetworkPolicy {
+	return &v1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "api",
+			Namespace: "default",
+		},
+		Spec: v1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "api", "tier": "backend"}},
+			PolicyTypes: []v1.PolicyType{v1.PolicyTypeEgress, v1.PolicyTypeIngress},
+			Ingress:     ingress,
+			Egress:      egress,
+		},
+	}
+}
+
+func TestCompareNetworkPolicies(t *testing.T) {
+	if err := logging.Configure(false); err != nil {
+		t.Fatalf("cannot configure logging: %s", err.Error())
+	}
+	if err := Init(context.Background()); err != nil {
+		t.Fatalf("cannot init networking package: %s", err.Error())
+	}
+
+	var (
+		httpName   = intstr.FromString("http")
+		httpNumber = intstr.FromInt(8080)
+		dnsPort    = intstr.FromInt(53)
+		udp        = v12.ProtocolUDP
+
+		frontend = v1.NetworkPolicyPeer{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "frontend"}}}
+		office   = v1.NetworkPolicyPeer{IPBlock: &v1.IPBlock{CIDR: "10.20.0.0/16"}}
+		dns      = v1.NetworkPolicyEgressRule{Ports: []v1.NetworkPolicyPort{{Protocol: &udp, Port: &dnsPort}}}
+		database = v1.NetworkPolicyEgressRule{
+			To: []v1.NetworkPolicyPeer{{IPBlock: &v1.IPBlock{CIDR: "192.168.10.0/24"}}},
+		}
+	)
+
+	pod := &v12.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "api-0", Namespace: "default", Labels: map[string]string{"app": "api", "tier": "backend"}},
+		Spec: v12.PodSpec{Containers: []v12.Container{{
+			Name:  "api",
+			Ports: []v12.ContainerPort{{Name: "http", ContainerPort: 8080}},
+		}}},
+	}
+
+	// the 1st cluster uses a named port in a single rule, the 2nd one splits the rule, reorders peers
+	// and writes the CIDR with host bits set
+	policy1 := newTestNetworkPolicy(
+		[]v1.NetworkPolicyIngressRule{{From: []v1.NetworkPolicyPeer{frontend, office}, Ports: []v1.NetworkPolicyPort{{Port: &httpName}}}},
+		[]v1.NetworkPolicyEgressRule{dns, database},
+	)
+	policy2 := newTestNetworkPolicy(
+		[]v1.NetworkPolicyIngressRule{
+			{From: []v1.NetworkPolicyPeer{{IPBlock: &v1.IPBlock{CIDR: "10.20.3.4/16"}}}, Ports: []v1.NetworkPolicyPort{{Port: &httpNumber}}},
+			{From: []v1.NetworkPolicyPeer{frontend}, Ports: []v1.NetworkPolicyPort{{Port: &httpNumber}}},
+		},
+		[]v1.NetworkPolicyEgressRule{database, dns},
+	)
+	policy2.Spec.PolicyTypes = []v1.PolicyType{v1.PolicyTypeIngress, v1.PolicyTypeEgress}
+
+	isDiffer, err := CompareNetworkPolicies(fake.NewSimpleClientset(policy1, pod), fake.NewSimpleClientset(policy2, pod), "default", nil)
+	if err != nil {
+		t.Fatalf("cannot compare network policies: %s", err.Error())
+	}
+	if isDiffer {
+		t.Error("Equivalent network policies are reported as different")
+	}
+
+	// the 2nd cluster misses the egress allowance to the database
+	policy2.Spec.Egress = []v1.NetworkPolicyEgressRule{dns}
+
+	models1, err := getNetworkPolicies(fake.NewSimpleClientset(policy1, pod), "default")
+	if err != nil {
+		t.Fatalf("cannot obtain network policies: %s", err.Error())
+	}
+	models2, err := getNetworkPolicies(fake.NewSimpleClientset(policy2, pod), "default")
+	if err != nil {
+		t.Fatalf("cannot obtain network policies: %s", err.Error())
+	}
+
+	err = compareSpecInNetworkPolicies(models1[0], models2[0])
+	if !errors.Is(err, ErrorEgressRulesDifferent) {
+		t.Fatalf("Missing egress allowance is not reported: %v", err)
+	}
+	if !strings.Contains(err.Error(), "Only in 1st cluster: [to CIDR 192.168.10.0/24 on any port]") {
+		t.Errorf("Missing egress allowance is reported imprecisely: %s", err.Error())
+	}
+
+	// a policy with egress rules enforces egress by default
+	policy2.Spec.PolicyTypes = nil
+	if types := newNetworkPolicyModel(*policy2, nil).PolicyTypes; strings.Join(types, ",") != "Egress,Ingress" {
+		t.Errorf("Default policy types are not applied: %v", types)
+	}
+}
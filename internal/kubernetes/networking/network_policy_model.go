@@ -0,0 +1,276 @@
+package networking
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	v12 "k8s.io/api/core/v1"
+	v1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// allowAllIngress and allowAllEgress are normalized rules allowing traffic of any peer on any port, they cover every other rule
+	allowAllIngress = "from anywhere on any port"
+	allowAllEgress  = "to anywhere on any port"
+)
+
+// networkPolicyModel is a normalized representation of a network policy. Rules are expanded into sets of peer/port
+// tuples, so policies splitting or ordering equivalent rules differently are equal
+type networkPolicyModel struct {
+	Name string
+
+	Labels      map[string]string
+	Annotations map[string]string
+
+	PodSelector string
+	PolicyTypes []string
+
+	Ingress map[string]struct{}
+	Egress  map[string]struct{}
+}
+
+// namedPortResolver resolves named ports of network policies to numbers using container ports of the namespace pods
+type namedPortResolver []v12.Pod
+
+// newNetworkPolicyModel normalizes the network policy. Rules of policy types which are not enforced are ignored
+func newNetworkPolicyModel(policy v1.NetworkPolicy, resolver namedPortResolver) networkPolicyModel {
+	model := networkPolicyModel{
+		Name:        policy.Name,
+		Labels:      policy.Labels,
+		Annotations: policy.Annotations,
+		PodSelector: formatPolicySelector(&policy.Spec.PodSelector),
+		PolicyTypes: getPolicyTypes(policy.Spec),
+		Ingress:     make(map[string]struct{}),
+		Egress:      make(map[string]struct{}),
+	}
+
+	podSelector, err := metav1.LabelSelectorAsSelector(&policy.Spec.PodSelector)
+	if err != nil {
+		podSelector = nil
+	}
+
+	for _, policyType := range model.PolicyTypes {
+		switch v1.PolicyType(policyType) {
+		case v1.PolicyTypeIngress:
+			for _, rule := range policy.Spec.Ingress {
+				addPolicyRuleTuples(model.Ingress, "from", rule.From, rule.Ports, resolver, []labels.Selector{podSelector})
+			}
+			collapseAllowAll(model.Ingress, allowAllIngress)
+		case v1.PolicyTypeEgress:
+			for _, rule := range policy.Spec.Egress {
+				addPolicyRuleTuples(model.Egress, "to", rule.To, rule.Ports, resolver, getSameNamespacePeerSelectors(rule.To))
+			}
+			collapseAllowAll(model.Egress, allowAllEgress)
+		}
+	}
+
+	return model
+}
+
+// getPolicyTypes returns sorted policy types of the policy applying the defaults of the API server: Ingress is always
+// enforced and Egress is enforced when the policy has egress rules
+func getPolicyTypes(spec v1.NetworkPolicySpec) []string {
+	policyTypes := make([]string, 0, 2)
+
+	if len(spec.PolicyTypes) == 0 {
+		policyTypes = append(policyTypes, string(v1.PolicyTypeIngress))
+		if len(spec.Egress) > 0 {
+			policyTypes = append(policyTypes, string(v1.PolicyTypeEgress))
+		}
+	}
+
+	for _, policyType := range spec.PolicyTypes {
+		policyTypes = append(policyTypes, string(policyType))
+	}
+	sort.Strings(policyTypes)
+
+	return policyTypes
+}
+
+// addPolicyRuleTuples adds every peer/port combination allowed by the rule. No peers mean any peer and no ports mean any port
+func addPolicyRuleTuples(tuples map[string]struct{}, direction string, peers []v1.NetworkPolicyPeer, ports []v1.NetworkPolicyPort, resolver namedPortResolver, selectors []labels.Selector) {
+	peerNames := make([]string, 0, len(peers))
+	for _, peer := range peers {
+		peerNames = append(peerNames, formatPolicyPeer(peer))
+	}
+	if len(peerNames) == 0 {
+		peerNames = append(peerNames, "anywhere")
+	}
+
+	portNames := make([]string, 0, len(ports))
+	for _, port := range ports {
+		portNames = append(portNames, formatPolicyPort(port, resolver, selectors))
+	}
+	if len(portNames) == 0 {
+		portNames = append(portNames, "any port")
+	}
+
+	for _, peer := range peerNames {
+		for _, port := range portNames {
+			tuples[fmt.Sprintf("%s %s on %s", direction, peer, port)] = struct{}{}
+		}
+	}
+}
+
+// collapseAllowAll removes rules covered by the rule allowing all traffic
+func collapseAllowAll(tuples map[string]struct{}, allowAll string) {
+	if _, ok := tuples[allowAll]; !ok {
+		return
+	}
+
+	for tuple := range tuples {
+		if tuple != allowAll {
+			delete(tuples, tuple)
+		}
+	}
+}
+
+// formatPolicyPeer returns a human-readable representation of the peer independent of selector and CIDR notation
+func formatPolicyPeer(peer v1.NetworkPolicyPeer) string {
+	if peer.IPBlock != nil {
+		except := make([]string, 0, len(peer.IPBlock.Except))
+		for _, cidr := range peer.IPBlock.Except {
+			except = append(except, normalizeCIDR(cidr))
+		}
+		sort.Strings(except)
+
+		if len(except) == 0 {
+			return fmt.Sprintf("CIDR %s", normalizeCIDR(peer.IPBlock.CIDR))
+		}
+
+		return fmt.Sprintf("CIDR %s except [%s]", normalizeCIDR(peer.IPBlock.CIDR), strings.Join(except, ", "))
+	}
+
+	if peer.NamespaceSelector == nil {
+		return fmt.Sprintf("pods %s of the policy namespace", formatPolicySelector(peer.PodSelector))
+	}
+
+	return fmt.Sprintf("pods %s of namespaces %s", formatPolicySelector(peer.PodSelector), formatPolicySelector(peer.NamespaceSelector))
+}
+
+// formatPolicySelector returns the selector in the canonical form. A missing or empty selector selects everything
+func formatPolicySelector(selector *metav1.LabelSelector) string {
+	if selector == nil {
+		return "{all}"
+	}
+
+	parsed, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return fmt.Sprintf("{%v}", *selector)
+	}
+
+	if parsed.Empty() {
+		return "{all}"
+	}
+
+	return fmt.Sprintf("{%s}", parsed.String())
+}
+
+// formatPolicyPort returns the protocol and the port number. Named ports are resolved when all pods declaring the name
+// agree on its number, otherwise the name is kept
+func formatPolicyPort(port v1.NetworkPolicyPort, resolver namedPortResolver, selectors []labels.Selector) string {
+	protocol := v12.ProtocolTCP
+	if port.Protocol != nil {
+		protocol = *port.Protocol
+	}
+
+	if port.Port == nil {
+		return fmt.Sprintf("%s/any", protocol)
+	}
+
+	if port.Port.Type == intstr.Int {
+		return fmt.Sprintf("%s/%d", protocol, port.Port.IntVal)
+	}
+
+	if number, ok := resolver.resolve(port.Port.StrVal, protocol, selectors); ok {
+		return fmt.Sprintf("%s/%d", protocol, number)
+	}
+
+	return fmt.Sprintf("%s/%s", protocol, port.Port.StrVal)
+}
+
+// resolve returns the number of a named port declared by containers of the pods matching any of the selectors
+func (r namedPortResolver) resolve(name string, protocol v12.Protocol, selectors []labels.Selector) (int32, bool) {
+	var (
+		number int32
+		found  bool
+	)
+
+	if value, err := strconv.Atoi(name); err == nil {
+		return int32(value), true
+	}
+
+	for _, pod := range r {
+		if !matchesAnySelector(pod.Labels, selectors) {
+			continue
+		}
+
+		for _, container := range pod.Spec.Containers {
+			for _, containerPort := range container.Ports {
+				containerProtocol := containerPort.Protocol
+				if containerProtocol == "" {
+					containerProtocol = v12.ProtocolTCP
+				}
+
+				if containerPort.Name != name || containerProtocol != protocol {
+					continue
+				}
+
+				if found && number != containerPort.ContainerPort {
+					return 0, false
+				}
+
+				number, found = containerPort.ContainerPort, true
+			}
+		}
+	}
+
+	return number, found
+}
+
+// getSameNamespacePeerSelectors returns pod selectors of the egress peers when all of them select pods of the policy
+// namespace, named ports of other peers cannot be resolved
+func getSameNamespacePeerSelectors(peers []v1.NetworkPolicyPeer) []labels.Selector {
+	selectors := make([]labels.Selector, 0, len(peers))
+
+	for _, peer := range peers {
+		if peer.PodSelector == nil || peer.NamespaceSelector != nil || peer.IPBlock != nil {
+			return nil
+		}
+
+		selector, err := metav1.LabelSelectorAsSelector(peer.PodSelector)
+		if err != nil {
+			return nil
+		}
+		selectors = append(selectors, selector)
+	}
+
+	return selectors
+}
+
+// matchesAnySelector checks whether labels match any of the selectors
+func matchesAnySelector(podLabels map[string]string, selectors []labels.Selector) bool {
+	for _, selector := range selectors {
+		if selector != nil && selector.Matches(labels.Set(podLabels)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizeCIDR returns the CIDR with host bits cleared and the address in the canonical form, e.g. 10.0.0.1/8 is 10.0.0.0/8
+func normalizeCIDR(cidr string) string {
+	_, network, err := net.ParseCIDR(strings.TrimSpace(cidr))
+	if err != nil {
+		return cidr
+	}
+
+	return network.String()
+}
@@ -0,0 +1,96 @@
+package networking
+
+import (
+	"fmt"
+
+	v12 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+)
+
+const (
+	// defaultInternalTrafficPolicy is applied by the API server when the policy is not specified and assumed for
+	// clusters which do not support the field
+	defaultInternalTrafficPolicy = "Cluster"
+
+	// ipFamiliesMinorVersion is the first minor version of Kubernetes 1.x serving ipFamilies, internalTrafficPolicy
+	// follows it. Services of older clusters are listed with the typed client
+	ipFamiliesMinorVersion = 20
+)
+
+// serviceList mirrors v1 ServiceList, internalTrafficPolicy and ipFamilies are unknown to the vendored client-go
+type serviceList struct {
+	Items []service `json:"items"`
+}
+
+// service mirrors a v1 Service
+type service struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec serviceSpec `json:"spec,omitempty"`
+}
+
+// serviceSpec mirrors a Service spec extended with fields added after the vendored client-go
+type serviceSpec struct {
+	v12.ServiceSpec
+
+	InternalTrafficPolicy *string  `json:"internalTrafficPolicy,omitempty"`
+	IPFamilies            []string `json:"ipFamilies,omitempty"`
+}
+
+// getServices returns services of the namespace. Services of clusters serving fields unknown to the vendored client-go
+// are decoded from the raw list to keep them
+func getServices(clientSet kubernetes.Interface, namespace string) ([]service, error) {
+	if !common.IsServerVersionAtLeast(clientSet, 1, ipFamiliesMinorVersion) {
+		services, err := clientSet.CoreV1().Services(namespace).List(metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("cannot obtain services list: %w", err)
+		}
+
+		return newServices(services), nil
+	}
+
+	list := serviceList{}
+
+	if err := common.GetRawResourceList(clientSet, "v1", namespace, "services", &list); err != nil {
+		return nil, fmt.Errorf("cannot obtain services list: %w", err)
+	}
+
+	return list.Items, nil
+}
+
+// newServices converts services obtained with the typed client to the mirror
+func newServices(list *v12.ServiceList) []service {
+	services := make([]service, 0, len(list.Items))
+
+	for _, item := range list.Items {
+		services = append(services, service{ObjectMeta: item.ObjectMeta, Spec: serviceSpec{ServiceSpec: item.Spec}})
+	}
+
+	return services
+}
+
+// getInternalTrafficPolicy returns the internal traffic policy of the service with the default applied
+func getInternalTrafficPolicy(spec serviceSpec) string {
+	if spec.InternalTrafficPolicy == nil {
+		return defaultInternalTrafficPolicy
+	}
+
+	return *spec.InternalTrafficPolicy
+}
+
+// getIPFamilies returns IP families of the service in their order, the first one is the primary family.
+// The legacy single ipFamily field is used for clusters which do not set ipFamilies
+func getIPFamilies(spec serviceSpec) []string {
+	if len(spec.IPFamilies) > 0 {
+		return spec.IPFamilies
+	}
+
+	if spec.IPFamily != nil {
+		return []string{string(*spec.IPFamily)}
+	}
+
+	return nil
+}
@@ -2,9 +2,9 @@ package networking
 
 import (
 	"fmt"
+	"strings"
 
 	v12 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
 	"sync"
@@ -21,13 +21,13 @@ func CompareServices(clientSet1, clientSet2 kubernetes.Interface, namespace stri
 		isClustersDiffer bool
 	)
 
-	services1, err := clientSet1.CoreV1().Services(namespace).List(metav1.ListOptions{})
+	services1, err := getServices(clientSet1, namespace)
 	if err != nil {
-		return false, fmt.Errorf("cannot obtain services list from 1st cluster: %w", err)
+		return false, fmt.Errorf("cannot obtain services from 1st cluster: %w", err)
 	}
-	services2, err := clientSet2.CoreV1().Services(namespace).List(metav1.ListOptions{})
+	services2, err := getServices(clientSet2, namespace)
 	if err != nil {
-		return false, fmt.Errorf("cannot obtain services list from 2nd cluster: %w", err)
+		return false, fmt.Errorf("cannot obtain services from 2nd cluster: %w", err)
 	}
 	mapServices1, mapServices2 := prepareServiceMaps(services1, services2, skipEntityList.GetByKind("secrets"))
 
@@ -37,12 +37,12 @@ func CompareServices(clientSet1, clientSet2 kubernetes.Interface, namespace stri
 }
 
 // prepareServiceMaps add value secrets in map
-func prepareServiceMaps(services1, services2 *v12.ServiceList, skipEntities skipper.SkipComponentNames) (map[string]types.IsAlreadyComparedFlag, map[string]types.IsAlreadyComparedFlag) { //nolint:gocritic,unused
+func prepareServiceMaps(services1, services2 []service, skipEntities skipper.SkipComponentNames) (map[string]types.IsAlreadyComparedFlag, map[string]types.IsAlreadyComparedFlag) { //nolint:gocritic,unused
 	mapServices1 := make(map[string]types.IsAlreadyComparedFlag)
 	mapServices2 := make(map[string]types.IsAlreadyComparedFlag)
 	var indexCheck types.IsAlreadyComparedFlag
 
-	for index, value := range services1.Items {
+	for index, value := range services1 {
 		if skipEntities.IsSkippedEntity(value.Name) {
 			log.Debugf("service %s is skipped from comparison due to its name", value.Name)
 			continue
@@ -51,7 +51,7 @@ func prepareServiceMaps(services1, services2 *v12.ServiceList, skipEntities skip
 		mapServices1[value.Name] = indexCheck
 
 	}
-	for index, value := range services2.Items {
+	for index, value := range services2 {
 		if skipEntities.IsSkippedEntity(value.Name) {
 			log.Debugf("service %s is skipped from comparison due to its name", value.Name)
 			continue
@@ -63,7 +63,7 @@ func prepareServiceMaps(services1, services2 *v12.ServiceList, skipEntities skip
 	return mapServices1, mapServices2
 }
 
-func compareServiceSpecInternals(wg *sync.WaitGroup, channel chan bool, name string, svc1, svc2 *v12.Service) {
+func compareServiceSpecInternals(wg *sync.WaitGroup, channel chan bool, name string, svc1, svc2 *service) {
 	var (
 		flag bool
 	)
@@ -96,7 +96,7 @@ func compareServiceSpecInternals(wg *sync.WaitGroup, channel chan bool, name str
 }
 
 // compareServicesSpecs set information about services
-func compareServicesSpecs(map1, map2 map[string]types.IsAlreadyComparedFlag, services1, services2 *v12.ServiceList) bool {
+func compareServicesSpecs(map1, map2 map[string]types.IsAlreadyComparedFlag, services1, services2 []service) bool {
 	var (
 		flag bool
 	)
@@ -118,7 +118,7 @@ func compareServicesSpecs(map1, map2 map[string]types.IsAlreadyComparedFlag, ser
 			index2.Check = true
 			map2[name] = index2
 
-			go compareServiceSpecInternals(wg, channel, name, &services1.Items[index1.Index], &services2.Items[index2.Index])
+			go compareServiceSpecInternals(wg, channel, name, &services1[index1.Index], &services2[index2.Index])
 		} else {
 			log.Infof("service '%s' does not exist in 2nd cluster", name)
 			flag = true
@@ -147,16 +147,42 @@ func compareServicesSpecs(map1, map2 map[string]types.IsAlreadyComparedFlag, ser
 	return flag
 }
 
+// servicePortKey returns a key that identifies a service port regardless of its position in the ports list
+func servicePortKey(port v12.ServicePort) string {
+	if port.Name != "" {
+		return port.Name
+	}
+
+	return fmt.Sprintf("%d/%s", port.Port, port.Protocol)
+}
+
+// isHeadlessService checks whether the service is headless
+func isHeadlessService(service service) bool {
+	return service.Spec.ClusterIP == v12.ClusterIPNone
+}
+
 // compareSpecInServices compares spec in services
-func compareSpecInServices(service1, service2 v12.Service) error {
+func compareSpecInServices(service1, service2 service) error {
 	if len(service1.Spec.Ports) != len(service2.Spec.Ports) {
 		return fmt.Errorf("%w. Name service: '%s'. In first service - %d ports, in second service - '%d' ports", ErrorPortsCountDifferent, service1.Name, len(service1.Spec.Ports), len(service2.Spec.Ports))
 	}
-	for index, value := range service1.Spec.Ports {
-		if value != service2.Spec.Ports[index] {
-			return fmt.Errorf("%w. Name service: '%s'. First service: %s-%d-%s. Second service: %s-%d-%s", ErrorPortInServicesDifferent, service1.Name, value.Name, value.Port, value.Protocol, service2.Spec.Ports[index].Name, service2.Spec.Ports[index].Port, service2.Spec.Ports[index].Protocol)
+
+	ports2 := make(map[string]v12.ServicePort, len(service2.Spec.Ports))
+	for _, port := range service2.Spec.Ports {
+		ports2[servicePortKey(port)] = port
+	}
+
+	for _, value := range service1.Spec.Ports {
+		port2, ok := ports2[servicePortKey(value)]
+		if !ok {
+			return fmt.Errorf("%w. Name service: '%s'. Port '%s' does not exist in second service", ErrorPortInServicesDifferent, service1.Name, servicePortKey(value))
+		}
+
+		if value.Protocol != port2.Protocol || value.Port != port2.Port || value.TargetPort != port2.TargetPort || (compareAllocatedValues && value.NodePort != port2.NodePort) {
+			return fmt.Errorf("%w. Name service: '%s'. First service: %s-%d-%s-%s-%d. Second service: %s-%d-%s-%s-%d", ErrorPortInServicesDifferent, service1.Name, value.Name, value.Port, value.Protocol, value.TargetPort.String(), value.NodePort, port2.Name, port2.Port, port2.Protocol, port2.TargetPort.String(), port2.NodePort)
 		}
 	}
+
 	if len(service1.Spec.Selector) != len(service2.Spec.Selector) {
 		return fmt.Errorf("%w. Name service: '%s'. In first service - %d selectors, in second service - '%d' selectors", ErrorSelectorsCountDifferent, service1.Name, len(service1.Spec.Selector), len(service2.Spec.Selector))
 	}
@@ -168,5 +194,93 @@ func compareSpecInServices(service1, service2 v12.Service) error {
 	if service1.Spec.Type != service2.Spec.Type {
 		return fmt.Errorf("%w. Name service: '%s'. First service type: %s. Second service type: %s", ErrorTypeInServicesDifferent, service1.Name, service1.Spec.Type, service2.Spec.Type)
 	}
+
+	if isHeadlessService(service1) != isHeadlessService(service2) {
+		return fmt.Errorf("%w. Name service: '%s'. First service headless: %t. Second service headless: %t", ErrorHeadlessInServicesDifferent, service1.Name, isHeadlessService(service1), isHeadlessService(service2))
+	}
+
+	if compareAllocatedValues && !isHeadlessService(service1) && service1.Spec.ClusterIP != service2.Spec.ClusterIP {
+		return fmt.Errorf("%w. Name service: '%s'. First service: %s. Second service: %s", ErrorClusterIPInServicesDifferent, service1.Name, service1.Spec.ClusterIP, service2.Spec.ClusterIP)
+	}
+
+	if compareAllocatedValues && service1.Spec.HealthCheckNodePort != service2.Spec.HealthCheckNodePort {
+		return fmt.Errorf("%w. Name service: '%s'. First service: %d. Second service: %d", ErrorHealthCheckNodePortDifferent, service1.Name, service1.Spec.HealthCheckNodePort, service2.Spec.HealthCheckNodePort)
+	}
+
+	if service1.Spec.ExternalName != service2.Spec.ExternalName {
+		return fmt.Errorf("%w. Name service: '%s'. First service: '%s'. Second service: '%s'", ErrorExternalNameInServicesDifferent, service1.Name, service1.Spec.ExternalName, service2.Spec.ExternalName)
+	}
+
+	if !isSessionAffinityEqual(service1.Spec, service2.Spec) {
+		return fmt.Errorf("%w. Name service: '%s'. First service: %s. Second service: %s", ErrorSessionAffinityDifferent, service1.Name, service1.Spec.SessionAffinity, service2.Spec.SessionAffinity)
+	}
+
+	if service1.Spec.ExternalTrafficPolicy != service2.Spec.ExternalTrafficPolicy {
+		return fmt.Errorf("%w. Name service: '%s'. First service: '%s'. Second service: '%s'", ErrorExternalTrafficPolicyDifferent, service1.Name, service1.Spec.ExternalTrafficPolicy, service2.Spec.ExternalTrafficPolicy)
+	}
+
+	if service1.Spec.PublishNotReadyAddresses != service2.Spec.PublishNotReadyAddresses {
+		return fmt.Errorf("%w. Name service: '%s'. First service: %t. Second service: %t", ErrorPublishNotReadyAddressesDifferent, service1.Name, service1.Spec.PublishNotReadyAddresses, service2.Spec.PublishNotReadyAddresses)
+	}
+
+	if !areStringSetsEqual(service1.Spec.LoadBalancerSourceRanges, service2.Spec.LoadBalancerSourceRanges) {
+		return fmt.Errorf("%w. Name service: '%s'. First service: [%s]. Second service: [%s]", ErrorLoadBalancerSourceRangesDifferent, service1.Name, strings.Join(service1.Spec.LoadBalancerSourceRanges, ", "), strings.Join(service2.Spec.LoadBalancerSourceRanges, ", "))
+	}
+
+	families1, families2 := strings.Join(getIPFamilies(service1.Spec), ", "), strings.Join(getIPFamilies(service2.Spec), ", ")
+	if families1 != families2 {
+		return fmt.Errorf("%w. Name service: '%s'. First service: [%s]. Second service: [%s]", ErrorIPFamilyInServicesDifferent, service1.Name, families1, families2)
+	}
+
+	if getInternalTrafficPolicy(service1.Spec) != getInternalTrafficPolicy(service2.Spec) {
+		return fmt.Errorf("%w. Name service: '%s'. First service: '%s'. Second service: '%s'", ErrorInternalTrafficPolicyDifferent, service1.Name, getInternalTrafficPolicy(service1.Spec), getInternalTrafficPolicy(service2.Spec))
+	}
+
 	return nil
 }
+
+// isSessionAffinityEqual compares session affinity type and its timeout
+func isSessionAffinityEqual(spec1, spec2 serviceSpec) bool {
+	if spec1.SessionAffinity != spec2.SessionAffinity {
+		return false
+	}
+
+	var (
+		timeout1 *int32
+		timeout2 *int32
+	)
+
+	if spec1.SessionAffinityConfig != nil && spec1.SessionAffinityConfig.ClientIP != nil {
+		timeout1 = spec1.SessionAffinityConfig.ClientIP.TimeoutSeconds
+	}
+	if spec2.SessionAffinityConfig != nil && spec2.SessionAffinityConfig.ClientIP != nil {
+		timeout2 = spec2.SessionAffinityConfig.ClientIP.TimeoutSeconds
+	}
+
+	if timeout1 != nil && timeout2 != nil {
+		return *timeout1 == *timeout2
+	}
+
+	return timeout1 == nil && timeout2 == nil
+}
+
+// areStringSetsEqual compares two string slices ignoring order of elements
+func areStringSetsEqual(slice1, slice2 []string) bool {
+	if len(slice1) != len(slice2) {
+		return false
+	}
+
+	set := make(map[string]int, len(slice1))
+	for _, value := range slice1 {
+		set[value]++
+	}
+
+	for _, value := range slice2 {
+		if set[value] == 0 {
+			return false
+		}
+		set[value]--
+	}
+
+	return true
+}
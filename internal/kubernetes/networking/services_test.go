@@ -1,12 +1,19 @@
 package networking
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
 	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+	"k8s-cluster-comparator/internal/logging"
 )
 
 var (
@@ -201,11 +208,16 @@ func initEnvironmemtForFifthTest3() {
 	})
 }
 
+// toServiceModel converts a service created with the typed client to the mirror compared by the package
+func toServiceModel(svc v1.Service) service {
+	return newServices(&v1.ServiceList{Items: []v1.Service{svc}})[0]
+}
+
 func TestCompareSpecInServices(t *testing.T) {
 	initEnvironmentForFirstTest3()
 	service1, _ := clusterClientSet1.CoreV1().Services("default").Get("testService", metav1.GetOptions{})
 	service2, _ := clusterClientSet2.CoreV1().Services("default").Get("testService", metav1.GetOptions{})
-	err := compareSpecInServices(*service1, *service2)
+	err := compareSpecInServices(toServiceModel(*service1), toServiceModel(*service2))
 	if !errors.Is(errors.Unwrap(err), ErrorPortsCountDifferent) {
 		t.Error("Error expected: 'the ports count are different'. But it was returned: ", err)
 	}
@@ -213,7 +225,14 @@ func TestCompareSpecInServices(t *testing.T) {
 	initEnvironmentForSecondTest3()
 	service1, _ = clusterClientSet1.CoreV1().Services("default").Get("testService", metav1.GetOptions{})
 	service2, _ = clusterClientSet2.CoreV1().Services("default").Get("testService", metav1.GetOptions{})
-	err = compareSpecInServices(*service1, *service2)
+	err = compareSpecInServices(toServiceModel(*service1), toServiceModel(*service2))
+	if err != nil {
+		t.Error("Allocated node ports must not be compared by default. But it was returned: ", err)
+	}
+
+	compareAllocatedValues = true
+	err = compareSpecInServices(toServiceModel(*service1), toServiceModel(*service2))
+	compareAllocatedValues = false
 	if !errors.Is(errors.Unwrap(err), ErrorPortInServicesDifferent) {
 		t.Error("Error expected: 'the port in the services is different'. But it was returned: ", err)
 	}
@@ -221,7 +240,7 @@ func TestCompareSpecInServices(t *testing.T) {
 	initEnvironmemtForThirdTest3()
 	service1, _ = clusterClientSet1.CoreV1().Services("default").Get("testService", metav1.GetOptions{})
 	service2, _ = clusterClientSet2.CoreV1().Services("default").Get("testService", metav1.GetOptions{})
-	err = compareSpecInServices(*service1, *service2)
+	err = compareSpecInServices(toServiceModel(*service1), toServiceModel(*service2))
 	if !errors.Is(errors.Unwrap(err), ErrorSelectorsCountDifferent) {
 		t.Error("Error expected: 'the selectors count are different'. But it was returned: ", err)
 	}
@@ -229,7 +248,7 @@ func TestCompareSpecInServices(t *testing.T) {
 	initEnvironmemtForFourthTest3()
 	service1, _ = clusterClientSet1.CoreV1().Services("default").Get("testService", metav1.GetOptions{})
 	service2, _ = clusterClientSet2.CoreV1().Services("default").Get("testService", metav1.GetOptions{})
-	err = compareSpecInServices(*service1, *service2)
+	err = compareSpecInServices(toServiceModel(*service1), toServiceModel(*service2))
 	if !errors.Is(errors.Unwrap(err), ErrorSelectorInServicesDifferent) {
 		t.Error("Error expected: 'the selector in the services is different'. But it was returned: ", err)
 	}
@@ -237,8 +256,138 @@ func TestCompareSpecInServices(t *testing.T) {
 	initEnvironmemtForFifthTest3()
 	service1, _ = clusterClientSet1.CoreV1().Services("default").Get("testService", metav1.GetOptions{})
 	service2, _ = clusterClientSet2.CoreV1().Services("default").Get("testService", metav1.GetOptions{})
-	err = compareSpecInServices(*service1, *service2)
+	err = compareSpecInServices(toServiceModel(*service1), toServiceModel(*service2))
 	if !errors.Is(errors.Unwrap(err), ErrorTypeInServicesDifferent) {
 		t.Error("the type in the services is different'. But it was returned: ", err)
 	}
 }
+
+func TestCompareExtendedSpecInServices(t *testing.T) {
+	var (
+		timeout1 int32 = 10800
+		timeout2 int32 = 600
+	)
+
+	base := v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "testService"},
+		Spec: v1.ServiceSpec{
+			Ports: []v1.ServicePort{
+				{Name: "http", Port: 80},
+				{Name: "https", Port: 443},
+			},
+			ClusterIP:       "10.0.0.1",
+			SessionAffinity: v1.ServiceAffinityClientIP,
+			SessionAffinityConfig: &v1.SessionAffinityConfig{
+				ClientIP: &v1.ClientIPConfig{TimeoutSeconds: &timeout1},
+			},
+			LoadBalancerSourceRanges: []string{"10.0.0.0/8", "192.168.0.0/16"},
+		},
+	}
+
+	service1 := toServiceModel(base)
+	service2 := toServiceModel(*base.DeepCopy())
+	service2.Spec.ClusterIP = "10.1.0.1"
+	service2.Spec.Ports = []v1.ServicePort{service1.Spec.Ports[1], service1.Spec.Ports[0]}
+	service2.Spec.LoadBalancerSourceRanges = []string{"192.168.0.0/16", "10.0.0.0/8"}
+
+	if err := compareSpecInServices(service1, service2); err != nil {
+		t.Error("Services differing in allocated cluster IP and order of ports must be equal. But it was returned: ", err)
+	}
+
+	service2.Spec.ClusterIP = v1.ClusterIPNone
+	if err := compareSpecInServices(service1, service2); !errors.Is(errors.Unwrap(err), ErrorHeadlessInServicesDifferent) {
+		t.Error("Error expected: 'the services are headless in one cluster only'. But it was returned: ", err)
+	}
+
+	service2.Spec.ClusterIP = service1.Spec.ClusterIP
+	service2.Spec.SessionAffinityConfig.ClientIP.TimeoutSeconds = &timeout2
+	if err := compareSpecInServices(service1, service2); !errors.Is(errors.Unwrap(err), ErrorSessionAffinityDifferent) {
+		t.Error("Error expected: 'the session affinity in the services is different'. But it was returned: ", err)
+	}
+
+	service2.Spec.SessionAffinityConfig.ClientIP.TimeoutSeconds = &timeout1
+	service2.Spec.ExternalTrafficPolicy = v1.ServiceExternalTrafficPolicyTypeLocal
+	if err := compareSpecInServices(service1, service2); !errors.Is(errors.Unwrap(err), ErrorExternalTrafficPolicyDifferent) {
+		t.Error("Error expected: 'the external traffic policy in the services is different'. But it was returned: ", err)
+	}
+
+	service2.Spec.ExternalTrafficPolicy = service1.Spec.ExternalTrafficPolicy
+	service1.Spec.IPFamilies = []string{"IPv4", "IPv6"}
+	service2.Spec.IPFamilies = []string{"IPv6", "IPv4"}
+	if err := compareSpecInServices(service1, service2); !errors.Is(errors.Unwrap(err), ErrorIPFamilyInServicesDifferent) {
+		t.Error("Error expected: 'the IP families in the services are different'. But it was returned: ", err)
+	}
+
+	service2.Spec.IPFamilies = []string{"IPv4", "IPv6"}
+	local := "Local"
+	service2.Spec.InternalTrafficPolicy = &local
+	if err := compareSpecInServices(service1, service2); !errors.Is(errors.Unwrap(err), ErrorInternalTrafficPolicyDifferent) {
+		t.Error("Error expected: 'the internal traffic policy in the services is different'. But it was returned: ", err)
+	}
+
+	cluster := defaultInternalTrafficPolicy
+	service2.Spec.InternalTrafficPolicy = &cluster
+	if err := compareSpecInServices(service1, service2); err != nil {
+		t.Error("Services with the default internal traffic policy set in one cluster only must be equal. But it was returned: ", err)
+	}
+}
+
+func TestDecodeServices(t *testing.T) {
+	list := serviceList{}
+
+	err := json.Unmarshal([]byte(`{"items": [{"metadata": {"name": "api"}, "spec": {"type": "ClusterIP", "ports": [{"name": "http", "port": 80}],
+		"ipFamilies": ["IPv6", "IPv4"], "ipFamilyPolicy": "PreferDualStack", "internalTrafficPolicy": "Local"}}]}`), &list)
+	if err != nil {
+		t.Fatalf("cannot decode services: %s", err.Error())
+	}
+
+	if families := getIPFamilies(list.Items[0].Spec); len(families) != 2 || families[0] != "IPv6" {
+		t.Errorf("IP families [IPv6, IPv4] expected. But it was returned: %v", families)
+	}
+	if policy := getInternalTrafficPolicy(list.Items[0].Spec); policy != "Local" {
+		t.Errorf("Internal traffic policy 'Local' expected. But it was returned: '%s'", policy)
+	}
+	if len(list.Items[0].Spec.Ports) != 1 || list.Items[0].Spec.Ports[0].Port != 80 {
+		t.Errorf("Fields of the embedded spec are not decoded: %v", list.Items[0].Spec.Ports)
+	}
+}
+
+func TestCompareServices(t *testing.T) {
+	if err := logging.Configure(false); err != nil {
+		t.Fatalf("cannot configure logging: %s", err.Error())
+	}
+	if err := Init(context.Background()); err != nil {
+		t.Fatalf("cannot init networking package: %s", err.Error())
+	}
+
+	newService := func(port int32) *v1.Service {
+		return &v1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "testService", Namespace: "default"},
+			Spec:       v1.ServiceSpec{Ports: []v1.ServicePort{{Name: "http", Port: port}}},
+		}
+	}
+
+	clientSet1, clientSet2 := fake.NewSimpleClientset(newService(80)), fake.NewSimpleClientset(newService(80))
+
+	// services of clusters older than 1.20 are listed with the typed client
+	clientSet2.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &version.Info{Major: "1", Minor: "17"}
+	if _, err := common.DiscoverAPISurface(clientSet2); err != nil {
+		t.Fatalf("cannot discover API surface: %s", err.Error())
+	}
+
+	isDiffer, err := CompareServices(clientSet1, clientSet2, "default", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if isDiffer {
+		t.Error("equal services are reported as different")
+	}
+
+	isDiffer, err = CompareServices(clientSet1, fake.NewSimpleClientset(newService(8080)), "default", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !isDiffer {
+		t.Error("services with different ports are reported as equal")
+	}
+}
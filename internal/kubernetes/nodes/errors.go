@@ -0,0 +1,13 @@
+package nodes
+
+import "errors"
+
+var (
+	ErrorInstanceTypesDifferent   = errors.New("the instance types in the node pools are different")
+	ErrorZonesDifferent           = errors.New("the zones in the node pools are different")
+	ErrorTaintsDifferent          = errors.New("the taints in the node pools are different")
+	ErrorAllocatableDifferent     = errors.New("the allocatable resources in the node pools are different")
+	ErrorKubeletVersionsDifferent = errors.New("the kubelet versions in the node pools are different")
+	ErrorRuntimeVersionsDifferent = errors.New("the container runtime versions in the node pools are different")
+	ErrorOSImagesDifferent        = errors.New("the OS images in the node pools are different")
+)
@@ -0,0 +1,196 @@
+package nodes
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	nodeRoleLabelPrefix = "node-role.kubernetes.io/"
+
+	// defaultNodePool groups nodes without pool and role labels
+	defaultNodePool = "default"
+
+	// allocatableCPUBucketMillis and allocatableMemoryBucketBytes are the granularity allocatable resources are compared with
+	allocatableCPUBucketMillis   = 100
+	allocatableMemoryBucketBytes = 64 * 1024 * 1024
+)
+
+var (
+	// nodePoolLabels lists labels naming the pool of a node set by managed node groups of cloud providers and node
+	// provisioners, the first label found is used
+	nodePoolLabels = []string{
+		"cloud.google.com/gke-nodepool",
+		"eks.amazonaws.com/nodegroup",
+		"alpha.eksctl.io/nodegroup-name",
+		"kubernetes.azure.com/agentpool",
+		"agentpool",
+		"karpenter.sh/nodepool",
+		"karpenter.sh/provisioner-name",
+		"node.kubernetes.io/pool",
+		"nodepool",
+		"pool",
+	}
+
+	// instanceTypeLabels and zoneLabels list well-known labels from the current to the deprecated one
+	instanceTypeLabels = []string{
+		"node.kubernetes.io/instance-type",
+		"beta.kubernetes.io/instance-type",
+	}
+	zoneLabels = []string{
+		"topology.kubernetes.io/zone",
+		"failure-domain.beta.kubernetes.io/zone",
+	}
+
+	// skippedTaints are set temporarily by the node lifecycle controller and the cluster autoscaler
+	skippedTaints = map[string]struct{}{
+		"ToBeDeletedByClusterAutoscaler":       {},
+		"DeletionCandidateOfClusterAutoscaler": {},
+	}
+	skippedTaintPrefix = "node.kubernetes.io/"
+)
+
+// nodePool summarizes nodes of one pool, every attribute is the set of values found on the nodes of the pool
+type nodePool struct {
+	Name       string
+	NodesCount int
+
+	InstanceTypes   map[string]struct{}
+	Zones           map[string]struct{}
+	Taints          map[string]struct{}
+	Allocatable     map[string]struct{}
+	KubeletVersions map[string]struct{}
+	RuntimeVersions map[string]struct{}
+	OSImages        map[string]struct{}
+}
+
+// groupNodesByPools summarizes nodes by their pools
+func groupNodesByPools(nodes []v1.Node) map[string]*nodePool {
+	pools := make(map[string]*nodePool)
+
+	for _, node := range nodes {
+		name := getNodePoolName(node)
+
+		pool, ok := pools[name]
+		if !ok {
+			pool = &nodePool{
+				Name:            name,
+				InstanceTypes:   make(map[string]struct{}),
+				Zones:           make(map[string]struct{}),
+				Taints:          make(map[string]struct{}),
+				Allocatable:     make(map[string]struct{}),
+				KubeletVersions: make(map[string]struct{}),
+				RuntimeVersions: make(map[string]struct{}),
+				OSImages:        make(map[string]struct{}),
+			}
+			pools[name] = pool
+		}
+
+		pool.NodesCount++
+
+		pool.InstanceTypes[getFirstLabel(node.Labels, instanceTypeLabels)] = struct{}{}
+		pool.Zones[getFirstLabel(node.Labels, zoneLabels)] = struct{}{}
+		pool.Taints[formatTaints(node.Spec.Taints)] = struct{}{}
+		pool.Allocatable[formatAllocatable(node.Status.Allocatable)] = struct{}{}
+		pool.KubeletVersions[getMinorVersion(node.Status.NodeInfo.KubeletVersion)] = struct{}{}
+		pool.RuntimeVersions[node.Status.NodeInfo.ContainerRuntimeVersion] = struct{}{}
+		pool.OSImages[node.Status.NodeInfo.OSImage] = struct{}{}
+	}
+
+	return pools
+}
+
+// getNodePoolName returns the pool of the node from the well-known pool labels, nodes without them are grouped by their roles
+func getNodePoolName(node v1.Node) string {
+	if name := getFirstLabel(node.Labels, nodePoolLabels); name != "<none>" {
+		return name
+	}
+
+	roles := make([]string, 0)
+	for label := range node.Labels {
+		if strings.HasPrefix(label, nodeRoleLabelPrefix) && label != nodeRoleLabelPrefix {
+			roles = append(roles, strings.TrimPrefix(label, nodeRoleLabelPrefix))
+		}
+	}
+
+	if len(roles) == 0 {
+		return defaultNodePool
+	}
+
+	sort.Strings(roles)
+
+	return strings.Join(roles, ",")
+}
+
+// getFirstLabel returns the value of the first label from the list which is set
+func getFirstLabel(nodeLabels map[string]string, keys []string) string {
+	for _, key := range keys {
+		if value, ok := nodeLabels[key]; ok && value != "" {
+			return value
+		}
+	}
+
+	return "<none>"
+}
+
+// formatTaints returns taints of the node regardless of their order, temporary taints are skipped
+func formatTaints(taints []v1.Taint) string {
+	result := make([]string, 0, len(taints))
+
+	for _, taint := range taints {
+		if _, ok := skippedTaints[taint.Key]; ok || strings.HasPrefix(taint.Key, skippedTaintPrefix) {
+			continue
+		}
+
+		if taint.Value == "" {
+			result = append(result, fmt.Sprintf("%s:%s", taint.Key, taint.Effect))
+		} else {
+			result = append(result, fmt.Sprintf("%s=%s:%s", taint.Key, taint.Value, taint.Effect))
+		}
+	}
+
+	if len(result) == 0 {
+		return "<none>"
+	}
+
+	sort.Strings(result)
+
+	return strings.Join(result, ",")
+}
+
+// formatAllocatable returns allocatable CPU and memory of the node rounded down to buckets, so nodes of the same instance
+// type differing by the memory reserved for the system are equal
+func formatAllocatable(allocatable v1.ResourceList) string {
+	cpu, memory := allocatable[v1.ResourceCPU], allocatable[v1.ResourceMemory]
+
+	cpuBucket := cpu.MilliValue() / allocatableCPUBucketMillis * allocatableCPUBucketMillis
+	memoryBucket := memory.Value() / allocatableMemoryBucketBytes * allocatableMemoryBucketBytes
+
+	return fmt.Sprintf("cpu=%s,memory=%s", resource.NewMilliQuantity(cpuBucket, resource.DecimalSI).String(), resource.NewQuantity(memoryBucket, resource.BinarySI).String())
+}
+
+// getMinorVersion returns the version without the patch part, e.g. "v1.17" for "v1.17.17-gke.1500", so nodes
+// in the middle of patch updates are not reported
+func getMinorVersion(version string) string {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return version
+	}
+
+	return parts[0] + "." + parts[1]
+}
+
+// formatSet returns sorted values of the set
+func formatSet(set map[string]struct{}) string {
+	values := make([]string, 0, len(set))
+	for value := range set {
+		values = append(values, value)
+	}
+	sort.Strings(values)
+
+	return strings.Join(values, ", ")
+}
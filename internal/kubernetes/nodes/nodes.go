@@ -0,0 +1,98 @@
+package nodes
+
+import (
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/skipper"
+)
+
+// CompareNodeFleets compares nodes of two given k8s-clusters grouped by node pools. Pools existing in one cluster only
+// and pools with different composition are reported instead of differences of single nodes
+func CompareNodeFleets(clientSet1, clientSet2 kubernetes.Interface, skipEntityList skipper.SkipEntitiesList) (bool, error) {
+	var (
+		flag bool
+	)
+
+	nodes1, err := clientSet1.CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain nodes list from 1st cluster: %w", err)
+	}
+
+	nodes2, err := clientSet2.CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain nodes list from 2nd cluster: %w", err)
+	}
+
+	pools1, pools2 := groupNodesByPools(nodes1.Items), groupNodesByPools(nodes2.Items)
+	skipEntities := skipEntityList.GetByKind("nodepools")
+
+	names := make([]string, 0, len(pools1)+len(pools2))
+	for name := range pools1 {
+		names = append(names, name)
+	}
+	for name := range pools2 {
+		if _, ok := pools1[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if skipEntities.IsSkippedEntity(name) {
+			log.Debugf("node pool %s is skipped from comparison due to its name", name)
+			continue
+		}
+
+		pool1, ok1 := pools1[name]
+		pool2, ok2 := pools2[name]
+
+		switch {
+		case !ok2:
+			log.Infof("node pool '%s' (%d nodes) does not exist in 2nd cluster", name, pool1.NodesCount)
+			flag = true
+		case !ok1:
+			log.Infof("node pool '%s' (%d nodes) does not exist in 1st cluster", name, pool2.NodesCount)
+			flag = true
+		default:
+			log.Debugf("node pool '%s' has %d nodes in 1st cluster and %d nodes in 2nd cluster", name, pool1.NodesCount, pool2.NodesCount)
+
+			for _, err := range compareNodePools(pool1, pool2) {
+				log.Infof("node pool '%s': %s", name, err.Error())
+				flag = true
+			}
+		}
+	}
+
+	return flag, nil
+}
+
+// compareNodePools compares the composition of a node pool existing in both clusters, every differing attribute is returned
+func compareNodePools(pool1, pool2 *nodePool) []error {
+	attributes := []struct {
+		err        error
+		set1, set2 map[string]struct{}
+	}{
+		{ErrorInstanceTypesDifferent, pool1.InstanceTypes, pool2.InstanceTypes},
+		{ErrorZonesDifferent, pool1.Zones, pool2.Zones},
+		{ErrorTaintsDifferent, pool1.Taints, pool2.Taints},
+		{ErrorAllocatableDifferent, pool1.Allocatable, pool2.Allocatable},
+		{ErrorKubeletVersionsDifferent, pool1.KubeletVersions, pool2.KubeletVersions},
+		{ErrorRuntimeVersionsDifferent, pool1.RuntimeVersions, pool2.RuntimeVersions},
+		{ErrorOSImagesDifferent, pool1.OSImages, pool2.OSImages},
+	}
+
+	differences := make([]error, 0)
+
+	for _, attribute := range attributes {
+		values1, values2 := formatSet(attribute.set1), formatSet(attribute.set2)
+		if values1 != values2 {
+			differences = append(differences, fmt.Errorf("%w. First cluster: [%s]. Second cluster: [%s]", attribute.err, values1, values2))
+		}
+	}
+
+	return differences
+}
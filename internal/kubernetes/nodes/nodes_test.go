@@ -0,0 +1,129 @@
+package nodes
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s-cluster-comparator/internal/logging"
+)
+
+func newNode(name, pool, zone, kubeletVersion string, taints ...v1.Taint) *v1.Node {
+	return &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				"cloud.google.com/gke-nodepool":    pool,
+				"node.kubernetes.io/instance-type": "n1-standard-4",
+				"topology.kubernetes.io/zone":      zone,
+				"kubernetes.io/hostname":           name,
+			},
+		},
+		Spec: v1.NodeSpec{Taints: taints},
+		Status: v1.NodeStatus{
+			Allocatable: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("3920m"),
+				v1.ResourceMemory: resource.MustParse("12Gi"),
+			},
+			NodeInfo: v1.NodeSystemInfo{
+				KubeletVersion:          kubeletVersion,
+				ContainerRuntimeVersion: "containerd://1.4.3",
+				OSImage:                 "Container-Optimized OS from Google",
+			},
+		},
+	}
+}
+
+func TestCompareNodeFleets(t *testing.T) {
+	if err := logging.Configure(false); err != nil {
+		t.Fatalf("cannot configure logging: %s", err.Error())
+	}
+	if err := Init(context.Background()); err != nil {
+		t.Fatalf("cannot init nodes package: %s", err.Error())
+	}
+
+	unschedulable := v1.Taint{Key: "node.kubernetes.io/unschedulable", Effect: v1.TaintEffectNoSchedule}
+
+	clientSet1 := fake.NewSimpleClientset(
+		newNode("default-1", "default", "europe-west1-b", "v1.17.17-gke.1500"),
+		newNode("default-2", "default", "europe-west1-b", "v1.17.15-gke.800"),
+	)
+	clientSet2 := fake.NewSimpleClientset(
+		newNode("default-a", "default", "europe-west1-b", "v1.17.17-gke.1500", unschedulable),
+	)
+
+	isDiffer, err := CompareNodeFleets(clientSet1, clientSet2, nil)
+	if err != nil {
+		t.Fatalf("cannot compare node fleets: %s", err.Error())
+	}
+	if isDiffer {
+		t.Error("Node fleets differing in node names and counts, patch versions and temporary taints only are reported as different")
+	}
+
+	clientSet2 = fake.NewSimpleClientset(
+		newNode("default-a", "default", "europe-west1-b", "v1.19.16-gke.1500"),
+		newNode("gpu-a", "gpu", "europe-west1-b", "v1.19.16-gke.1500", v1.Taint{Key: "nvidia.com/gpu", Value: "present", Effect: v1.TaintEffectNoSchedule}),
+	)
+
+	isDiffer, err = CompareNodeFleets(clientSet1, clientSet2, nil)
+	if err != nil {
+		t.Fatalf("cannot compare node fleets: %s", err.Error())
+	}
+	if !isDiffer {
+		t.Error("Node fleets with different pools and kubelet versions are not reported")
+	}
+}
+
+func TestCompareNodePools(t *testing.T) {
+	gpuTaint := v1.Taint{Key: "nvidia.com/gpu", Value: "present", Effect: v1.TaintEffectNoSchedule}
+
+	pools1 := groupNodesByPools([]v1.Node{*newNode("gpu-1", "gpu", "europe-west1-b", "v1.17.17", gpuTaint)})
+	pools2 := groupNodesByPools([]v1.Node{*newNode("gpu-1", "gpu", "europe-west1-c", "v1.19.16")})
+
+	differences := compareNodePools(pools1["gpu"], pools2["gpu"])
+	if len(differences) != 3 {
+		t.Fatalf("3 differences expected, but %d were returned: %v", len(differences), differences)
+	}
+	if !errors.Is(differences[0], ErrorZonesDifferent) || !errors.Is(differences[1], ErrorTaintsDifferent) || !errors.Is(differences[2], ErrorKubeletVersionsDifferent) {
+		t.Error("Errors expected: zones, taints and kubelet versions differences. But it was returned: ", differences)
+	}
+
+	if expected := "the kubelet versions in the node pools are different. First cluster: [v1.17]. Second cluster: [v1.19]"; differences[2].Error() != expected {
+		t.Errorf("Error expected: '%s'. But it was returned: '%s'", expected, differences[2].Error())
+	}
+}
+
+func TestFormatAllocatable(t *testing.T) {
+	allocatable1 := v1.ResourceList{v1.ResourceCPU: resource.MustParse("3920m"), v1.ResourceMemory: resource.MustParse("12673780Ki")}
+	allocatable2 := v1.ResourceList{v1.ResourceCPU: resource.MustParse("3.94"), v1.ResourceMemory: resource.MustParse("12673772Ki")}
+
+	if formatAllocatable(allocatable1) != formatAllocatable(allocatable2) {
+		t.Errorf("Allocatable resources differing by a few Ki must be equal. But they are '%s' and '%s'", formatAllocatable(allocatable1), formatAllocatable(allocatable2))
+	}
+
+	allocatable2[v1.ResourceMemory] = resource.MustParse("15Gi")
+	if formatAllocatable(allocatable1) == formatAllocatable(allocatable2) {
+		t.Error("Allocatable resources of different instance types are reported as equal")
+	}
+}
+
+func TestGetNodePoolName(t *testing.T) {
+	node := v1.Node{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{
+		"node-role.kubernetes.io/master":        "",
+		"node-role.kubernetes.io/control-plane": "",
+	}}}
+
+	if name := getNodePoolName(node); name != "control-plane,master" {
+		t.Errorf("Node pool 'control-plane,master' expected for a node without pool labels. But it was returned: '%s'", name)
+	}
+
+	node.Labels = nil
+	if name := getNodePoolName(node); name != defaultNodePool {
+		t.Errorf("Node pool '%s' expected for a node without labels. But it was returned: '%s'", defaultNodePool, name)
+	}
+}
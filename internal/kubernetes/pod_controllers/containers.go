@@ -9,6 +9,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"k8s-cluster-comparator/internal/kubernetes/common"
+	"k8s-cluster-comparator/internal/kubernetes/kv_maps"
 	"k8s-cluster-comparator/internal/kubernetes/types"
 )
 
@@ -217,8 +218,10 @@ func CompareEnvInContainers(env1, env2 []v12.EnvVar, namespace string, simplifie
 					if err != nil {
 						panic(err.Error())
 					}
-					if string(secret1.Data[env1[pod1EnvIdx].ValueFrom.SecretKeyRef.Key]) != string(secret2.Data[env2[pod1EnvIdx].ValueFrom.SecretKeyRef.Key]) {
-						return fmt.Errorf("%w. Environment in container 1: SecretKeyRef.Key = %s, value = %s. Environment in container 2: SecretKeyRef.Key = %s, value = %s", ErrorDifferentValueSecretKey, env1[pod1EnvIdx].ValueFrom.SecretKeyRef.Key, string(secret1.Data[env1[pod1EnvIdx].ValueFrom.SecretKeyRef.Key]), env2[pod1EnvIdx].ValueFrom.SecretKeyRef.Key, string(secret2.Data[env2[pod1EnvIdx].ValueFrom.SecretKeyRef.Key]))
+					secretValue1 := secret1.Data[env1[pod1EnvIdx].ValueFrom.SecretKeyRef.Key]
+					secretValue2 := secret2.Data[env2[pod1EnvIdx].ValueFrom.SecretKeyRef.Key]
+					if !kv_maps.AreSecretValuesEqual(secretValue1, secretValue2) {
+						return fmt.Errorf("%w. SecretKeyRef %s:%s: %s", ErrorDifferentValueSecretKey, env1[pod1EnvIdx].ValueFrom.SecretKeyRef.Name, env1[pod1EnvIdx].ValueFrom.SecretKeyRef.Key, kv_maps.DescribeSecretValuesDiff(secretValue1, secretValue2))
 					}
 				}
 			} else if env1[pod1EnvIdx].ValueFrom != nil || env2[pod1EnvIdx].ValueFrom != nil {
@@ -7,11 +7,12 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
+	"k8s-cluster-comparator/internal/kubernetes/autoscaling"
 	"k8s-cluster-comparator/internal/kubernetes/skipper"
 	"k8s-cluster-comparator/internal/kubernetes/types"
 )
 
-func CompareDeployments(clientSet1, clientSet2 kubernetes.Interface, namespace string, skipEntityList skipper.SkipEntitiesList) (bool, error) {
+func CompareDeployments(clientSet1, clientSet2 kubernetes.Interface, autoscalers1, autoscalers2 *autoscaling.Autoscalers, namespace string, skipEntityList skipper.SkipEntitiesList) (bool, error) {
 	var (
 		isClustersDiffer bool
 	)
@@ -28,6 +29,9 @@ func CompareDeployments(clientSet1, clientSet2 kubernetes.Interface, namespace s
 
 	apc1List, map1, apc2List, map2 := prepareDeploymentMaps(depl1, depl2, skipEntityList.GetByKind("deployments"))
 
+	setReplicaBounds(autoscalers1.ScaleTargets(), "Deployment", apc1List)
+	setReplicaBounds(autoscalers2.ScaleTargets(), "Deployment", apc2List)
+
 	isClustersDiffer = comparePodControllerSpecs(&clusterCompareTask{
 		Client:                   clientSet1,
 		APCList:                  apc1List,
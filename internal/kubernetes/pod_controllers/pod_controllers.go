@@ -139,15 +139,22 @@ func comparePodControllerSpecInternals(wg *sync.WaitGroup, channel chan bool, na
 		return
 	}
 
-	if apc1.Replicas != nil || apc2.Replicas != nil {
-		if *apc1.Replicas != *apc2.Replicas {
-			log.Infof("%s:%s: number of replicas is different: %d and %d", kind, name, *apc1.Replicas, *apc2.Replicas)
+	if apc1.ReplicaBounds != nil && apc2.ReplicaBounds != nil {
+		// replicas are managed by autoscalers and depend on the load, so their bounds are compared instead
+		if compareReplicaBounds(kind, name, apc1.ReplicaBounds, apc2.ReplicaBounds) {
+			flag = true
+		}
+	} else {
+		if apc1.Replicas != nil || apc2.Replicas != nil {
+			if *apc1.Replicas != *apc2.Replicas {
+				log.Infof("%s:%s: number of replicas is different: %d and %d", kind, name, *apc1.Replicas, *apc2.Replicas)
+				flag = true
+			}
+		}
+		if (apc1.Replicas != nil && apc2.Replicas == nil) || (apc2.Replicas != nil && apc1.Replicas == nil) {
+			log.Infof("%s:%s: strange replicas specification difference: %#v and %#v", kind, apc1.Replicas, apc2.Replicas)
 			flag = true
 		}
-	}
-	if (apc1.Replicas != nil && apc2.Replicas == nil) || (apc2.Replicas != nil && apc1.Replicas == nil) {
-		log.Infof("%s:%s: strange replicas specification difference: %#v and %#v", kind, apc1.Replicas, apc2.Replicas)
-		flag = true
 	}
 
 	// fill in the information that will be used for comparison
@@ -166,6 +173,12 @@ func comparePodControllerSpecInternals(wg *sync.WaitGroup, channel chan bool, na
 		flag = true
 	}
 
+	if kind == "statefulsets" {
+		if compareStatefulSetVolumeClaims(apc1, apc2) {
+			flag = true
+		}
+	}
+
 	log.Debugf("----- End checking %s: '%s' -----", kind, name)
 
 	channel <- flag
@@ -1157,7 +1157,7 @@ func TestCompareContainers(t *testing.T) {
 	}
 
 	if err := Init(ctx); err != nil {
-		t.Errorf("cannot init pod_controllers package: %w", err)
+		t.Errorf("cannot init pod_controllers package: %s", err)
 	}
 	deployments1, _ := clusterClientSet1.AppsV1().Deployments("default").List(metav1.ListOptions{})
 	deployments2, _ := clusterClientSet2.AppsV1().Deployments("default").List(metav1.ListOptions{})
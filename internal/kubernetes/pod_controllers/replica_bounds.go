@@ -0,0 +1,27 @@
+package pod_controllers
+
+import (
+	"k8s-cluster-comparator/internal/kubernetes/autoscaling"
+)
+
+// setReplicaBounds sets replica bounds of the controllers of the given kind which are managed by horizontal pod autoscalers
+func setReplicaBounds(targets map[string]autoscaling.ReplicaBounds, kind string, apcList []AbstractPodController) {
+	for index := range apcList {
+		if bounds, ok := targets[kind+"/"+apcList[index].Name]; ok {
+			apcList[index].ReplicaBounds = &bounds
+		}
+	}
+}
+
+// compareReplicaBounds compares minimum and maximum replicas of autoscalers managing the controller in both clusters
+func compareReplicaBounds(kind, name string, bounds1, bounds2 *autoscaling.ReplicaBounds) bool {
+	if bounds1.MinReplicas != bounds2.MinReplicas || bounds1.MaxReplicas != bounds2.MaxReplicas {
+		log.Infof("%s:%s: replicas bounds of autoscalers are different: %d-%d (%s) and %d-%d (%s)", kind, name,
+			bounds1.MinReplicas, bounds1.MaxReplicas, bounds1.AutoscalerName, bounds2.MinReplicas, bounds2.MaxReplicas, bounds2.AutoscalerName)
+		return true
+	}
+
+	log.Debugf("%s:%s: replicas are managed by autoscalers in both clusters, their number is not compared", kind, name)
+
+	return false
+}
@@ -0,0 +1,35 @@
+package pod_controllers
+
+import (
+	"context"
+	"testing"
+
+	"k8s-cluster-comparator/internal/kubernetes/autoscaling"
+	"k8s-cluster-comparator/internal/logging"
+)
+
+func TestReplicaBounds(t *testing.T) {
+	if err := logging.Configure(false); err != nil {
+		t.Fatalf("cannot configure logging: %s", err.Error())
+	}
+	if err := Init(context.Background()); err != nil {
+		t.Fatalf("cannot init pod_controllers package: %s", err.Error())
+	}
+
+	apcList1 := []AbstractPodController{{Name: "api"}, {Name: "worker"}}
+	apcList2 := []AbstractPodController{{Name: "api"}, {Name: "worker"}}
+
+	setReplicaBounds(map[string]autoscaling.ReplicaBounds{"Deployment/api": {AutoscalerName: "api", MinReplicas: 2, MaxReplicas: 10}}, "Deployment", apcList1)
+	setReplicaBounds(map[string]autoscaling.ReplicaBounds{"Deployment/api": {AutoscalerName: "api", MinReplicas: 2, MaxReplicas: 20}}, "Deployment", apcList2)
+
+	if apcList1[0].ReplicaBounds == nil || apcList1[1].ReplicaBounds != nil {
+		t.Fatalf("replica bounds are set for wrong controllers: %#v", apcList1)
+	}
+
+	if compareReplicaBounds("deployments", "api", apcList1[0].ReplicaBounds, apcList1[0].ReplicaBounds) {
+		t.Error("Equal replica bounds are reported as different")
+	}
+	if !compareReplicaBounds("deployments", "api", apcList1[0].ReplicaBounds, apcList2[0].ReplicaBounds) {
+		t.Error("Different maximum replicas are not reported")
+	}
+}
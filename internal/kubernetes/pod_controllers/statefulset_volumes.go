@@ -0,0 +1,153 @@
+package pod_controllers
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/storage"
+)
+
+// statefulSetClaims holds persistent volume claims created for StatefulSet ordinals by the claim template name
+type statefulSetClaims map[string]map[int32]*v1.PersistentVolumeClaim
+
+// setPersistentVolumeClaims lists claims of the namespace once and sets them to StatefulSets with volume claim templates
+func setPersistentVolumeClaims(clientSet kubernetes.Interface, namespace string, apcList []AbstractPodController) error {
+	var hasTemplates bool
+	for index := range apcList {
+		hasTemplates = hasTemplates || len(apcList[index].VolumeClaimTemplates) > 0
+	}
+
+	if !hasTemplates {
+		return nil
+	}
+
+	list, err := clientSet.CoreV1().PersistentVolumeClaims(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	claims := make(map[string]*v1.PersistentVolumeClaim, len(list.Items))
+	for index := range list.Items {
+		claims[list.Items[index].Name] = &list.Items[index]
+	}
+
+	for index := range apcList {
+		if len(apcList[index].VolumeClaimTemplates) > 0 {
+			apcList[index].PersistentVolumeClaims = claims
+		}
+	}
+
+	return nil
+}
+
+// compareStatefulSetVolumeClaims compares volume claim templates of StatefulSets and the claims actually created for their
+// ordinals in both clusters, reporting missing claims and claims expanded beyond their template
+func compareStatefulSetVolumeClaims(apc1, apc2 *AbstractPodController) bool {
+	var (
+		flag bool
+	)
+
+	templates1 := getVolumeClaimTemplatesMap(apc1.VolumeClaimTemplates)
+	templates2 := getVolumeClaimTemplatesMap(apc2.VolumeClaimTemplates)
+
+	for name, template1 := range templates1 {
+		template2, ok := templates2[name]
+		if !ok {
+			log.Infof("statefulsets %s: volume claim template '%s' does not exist in 2nd cluster", apc1.Name, name)
+			flag = true
+			continue
+		}
+
+		if err := storage.ComparePVCSpecs(template1, template2); err != nil {
+			log.Infof("statefulsets %s: volume claim template '%s': %s", apc1.Name, name, err.Error())
+			flag = true
+		}
+	}
+
+	for name := range templates2 {
+		if _, ok := templates1[name]; !ok {
+			log.Infof("statefulsets %s: volume claim template '%s' does not exist in 1st cluster", apc1.Name, name)
+			flag = true
+		}
+	}
+
+	claims1, isDiffer := getStatefulSetClaims(apc1, "1st")
+	flag = flag || isDiffer
+
+	claims2, isDiffer := getStatefulSetClaims(apc2, "2nd")
+	flag = flag || isDiffer
+
+	for templateName, ordinals1 := range claims1 {
+		for ordinal, claim1 := range ordinals1 {
+			claim2, ok := claims2[templateName][ordinal]
+			if !ok {
+				continue
+			}
+
+			if err := storage.ComparePVCSpecs(*claim1, *claim2); err != nil {
+				log.Infof("statefulsets %s: claim '%s' of ordinal %d: %s", apc1.Name, claim1.Name, ordinal, err.Error())
+				flag = true
+			}
+		}
+	}
+
+	return flag
+}
+
+// getVolumeClaimTemplatesMap returns volume claim templates by their names
+func getVolumeClaimTemplatesMap(templates []v1.PersistentVolumeClaim) map[string]v1.PersistentVolumeClaim {
+	templatesMap := make(map[string]v1.PersistentVolumeClaim, len(templates))
+
+	for _, template := range templates {
+		templatesMap[template.Name] = template
+	}
+
+	return templatesMap
+}
+
+// getStatefulSetClaims looks up claims of every StatefulSet ordinal of the cluster, reporting missing claims and
+// claims which request more storage than their template, i.e. expanded ones
+func getStatefulSetClaims(apc *AbstractPodController, clusterName string) (statefulSetClaims, bool) {
+	var (
+		flag bool
+
+		claims   = make(statefulSetClaims, len(apc.VolumeClaimTemplates))
+		replicas = int32(1)
+	)
+
+	if apc.Replicas != nil {
+		replicas = *apc.Replicas
+	}
+
+	for _, template := range apc.VolumeClaimTemplates {
+		claims[template.Name] = make(map[int32]*v1.PersistentVolumeClaim, replicas)
+
+		for ordinal := int32(0); ordinal < replicas; ordinal++ {
+			claimName := fmt.Sprintf("%s-%s-%d", template.Name, apc.Name, ordinal)
+
+			claim, ok := apc.PersistentVolumeClaims[claimName]
+			if !ok {
+				log.Infof("statefulsets %s: claim '%s' of ordinal %d does not exist in %s cluster", apc.Name, claimName, ordinal, clusterName)
+				flag = true
+				continue
+			}
+
+			requested := claim.Spec.Resources.Requests[v1.ResourceStorage]
+			templateSize := template.Spec.Resources.Requests[v1.ResourceStorage]
+			if requested.Cmp(templateSize) > 0 {
+				log.Infof("statefulsets %s: claim '%s' of ordinal %d is expanded in %s cluster: %s requested while the template requests %s", apc.Name, claimName, ordinal, clusterName, requested.String(), templateSize.String())
+				flag = true
+			} else if requested.Cmp(templateSize) < 0 {
+				log.Infof("statefulsets %s: claim '%s' of ordinal %d in %s cluster requests %s while the template requests %s", apc.Name, claimName, ordinal, clusterName, requested.String(), templateSize.String())
+				flag = true
+			}
+
+			claims[template.Name][ordinal] = claim
+		}
+	}
+
+	return claims, flag
+}
@@ -0,0 +1,78 @@
+package pod_controllers
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s-cluster-comparator/internal/logging"
+)
+
+func newClaim(name, size string) *v1.PersistentVolumeClaim {
+	return &v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: v1.PersistentVolumeClaimSpec{
+			AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+			Resources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse(size)},
+			},
+		},
+		Status: v1.PersistentVolumeClaimStatus{Phase: v1.ClaimBound},
+	}
+}
+
+func TestCompareStatefulSetVolumeClaims(t *testing.T) {
+	if err := logging.Configure(false); err != nil {
+		t.Fatalf("cannot configure logging: %s", err.Error())
+	}
+	if err := Init(context.Background()); err != nil {
+		t.Fatalf("cannot init pod_controllers package: %s", err.Error())
+	}
+
+	replicas := int32(2)
+	newStatefulSet := func(t *testing.T, claims ...*v1.PersistentVolumeClaim) *AbstractPodController {
+		objects := make([]runtime.Object, 0, len(claims))
+		for _, claim := range claims {
+			objects = append(objects, claim)
+		}
+
+		apcList := []AbstractPodController{{
+			Name:                 "app",
+			Replicas:             &replicas,
+			VolumeClaimTemplates: []v1.PersistentVolumeClaim{*newClaim("data", "1Gi")},
+		}}
+		if err := setPersistentVolumeClaims(fake.NewSimpleClientset(objects...), "default", apcList); err != nil {
+			t.Fatalf("cannot obtain volume claims: %s", err.Error())
+		}
+
+		return &apcList[0]
+	}
+
+	apc1 := newStatefulSet(t, newClaim("data-app-0", "1Gi"), newClaim("data-app-1", "1Gi"))
+	apc2 := newStatefulSet(t, newClaim("data-app-0", "1Gi"), newClaim("data-app-1", "1Gi"))
+
+	if compareStatefulSetVolumeClaims(apc1, apc2) {
+		t.Error("Equal volume claims are reported as different")
+	}
+
+	apc2 = newStatefulSet(t, newClaim("data-app-0", "1Gi"))
+
+	if !compareStatefulSetVolumeClaims(apc1, apc2) {
+		t.Error("Missing claim of an ordinal is not reported")
+	}
+
+	apc2 = newStatefulSet(t, newClaim("data-app-0", "1Gi"), newClaim("data-app-1", "5Gi"))
+
+	claims, isDiffer := getStatefulSetClaims(apc2, "2nd")
+	if !isDiffer {
+		t.Error("Expanded claim is not reported")
+	}
+	if len(claims["data"]) != 2 {
+		t.Errorf("expected claims of 2 ordinals, got %d", len(claims["data"]))
+	}
+}
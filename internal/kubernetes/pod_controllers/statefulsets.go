@@ -7,11 +7,12 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
+	"k8s-cluster-comparator/internal/kubernetes/autoscaling"
 	"k8s-cluster-comparator/internal/kubernetes/skipper"
 	"k8s-cluster-comparator/internal/kubernetes/types"
 )
 
-func CompareStateFulSets(clientSet1, clientSet2 kubernetes.Interface, namespace string, skipEntityList skipper.SkipEntitiesList) (bool, error) {
+func CompareStateFulSets(clientSet1, clientSet2 kubernetes.Interface, autoscalers1, autoscalers2 *autoscaling.Autoscalers, namespace string, skipEntityList skipper.SkipEntitiesList) (bool, error) {
 	var (
 		isClustersDiffer bool
 	)
@@ -27,6 +28,17 @@ func CompareStateFulSets(clientSet1, clientSet2 kubernetes.Interface, namespace
 
 	apc1List, map1, apc2List, map2 := prepareStatefulSetMaps(statefulSet1, statefulSet2, skipEntityList.GetByKind("statefulsets"))
 
+	setReplicaBounds(autoscalers1.ScaleTargets(), "StatefulSet", apc1List)
+	setReplicaBounds(autoscalers2.ScaleTargets(), "StatefulSet", apc2List)
+
+	if err := setPersistentVolumeClaims(clientSet1, namespace, apc1List); err != nil {
+		return false, fmt.Errorf("cannot obtain persistent volume claims from 1st cluster: %w", err)
+	}
+
+	if err := setPersistentVolumeClaims(clientSet2, namespace, apc2List); err != nil {
+		return false, fmt.Errorf("cannot obtain persistent volume claims from 2nd cluster: %w", err)
+	}
+
 	isClustersDiffer = comparePodControllerSpecs(&clusterCompareTask{
 		Client:                   clientSet1,
 		APCList:                  apc1List,
@@ -75,6 +87,8 @@ func prepareStatefulSetMaps(obj1, obj2 *v1.StatefulSetList, skipEntities skipper
 			Replicas:         value.Spec.Replicas,
 			PodLabelSelector: value.Spec.Selector,
 			PodTemplateSpec:  value.Spec.Template,
+
+			VolumeClaimTemplates: value.Spec.VolumeClaimTemplates,
 		})
 	}
 
@@ -100,6 +114,8 @@ func prepareStatefulSetMaps(obj1, obj2 *v1.StatefulSetList, skipEntities skipper
 			Replicas:         value.Spec.Replicas,
 			PodLabelSelector: value.Spec.Selector,
 			PodTemplateSpec:  value.Spec.Template,
+
+			VolumeClaimTemplates: value.Spec.VolumeClaimTemplates,
 		})
 	}
 
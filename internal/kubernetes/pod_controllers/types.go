@@ -4,6 +4,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"k8s-cluster-comparator/internal/kubernetes/autoscaling"
 	"k8s-cluster-comparator/internal/kubernetes/types"
 )
 
@@ -18,6 +19,15 @@ type AbstractPodController struct {
 
 	Replicas *int32
 
+	// ReplicaBounds are set when the controller is managed by a horizontal pod autoscaler
+	ReplicaBounds *autoscaling.ReplicaBounds
+
 	PodLabelSelector *v12.LabelSelector
 	PodTemplateSpec  v1.PodTemplateSpec
+
+	// VolumeClaimTemplates are set for StatefulSets only
+	VolumeClaimTemplates []v1.PersistentVolumeClaim
+
+	// PersistentVolumeClaims are claims of the namespace by their names, set for StatefulSets with volume claim templates only
+	PersistentVolumeClaims map[string]*v1.PersistentVolumeClaim
 }
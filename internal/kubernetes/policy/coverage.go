@@ -0,0 +1,101 @@
+package policy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/skipper"
+)
+
+// workload is a pod controller whose pods may be protected by pod disruption budgets
+type workload struct {
+	Kind string
+	Name string
+
+	PodLabels map[string]string
+}
+
+// getWorkloads returns Deployments and StatefulSets of the namespace with labels of their pod templates
+func getWorkloads(clientSet kubernetes.Interface, namespace string, skipEntityList skipper.SkipEntitiesList) ([]workload, error) {
+	deployments, err := clientSet.AppsV1().Deployments(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot obtain deployments list: %w", err)
+	}
+
+	statefulSets, err := clientSet.AppsV1().StatefulSets(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot obtain statefulsets list: %w", err)
+	}
+
+	var (
+		workloads = make([]workload, 0, len(deployments.Items)+len(statefulSets.Items))
+
+		skipDeployments  = skipEntityList.GetByKind("deployments")
+		skipStatefulSets = skipEntityList.GetByKind("statefulsets")
+	)
+
+	for _, deployment := range deployments.Items {
+		if !skipDeployments.IsSkippedEntity(deployment.Name) {
+			workloads = append(workloads, workload{Kind: "deployment", Name: deployment.Name, PodLabels: deployment.Spec.Template.Labels})
+		}
+	}
+
+	for _, statefulSet := range statefulSets.Items {
+		if !skipStatefulSets.IsSkippedEntity(statefulSet.Name) {
+			workloads = append(workloads, workload{Kind: "statefulset", Name: statefulSet.Name, PodLabels: statefulSet.Spec.Template.Labels})
+		}
+	}
+
+	return workloads, nil
+}
+
+// getProtectingBudgets returns sorted names of budgets selecting pods of the workload
+func getProtectingBudgets(budgets []pdb, w workload) []string {
+	names := make([]string, 0)
+
+	for _, budget := range budgets {
+		if budget.selectsPods(w.PodLabels) {
+			names = append(names, budget.Name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// compareWorkloadsCoverage reports workloads existing in both clusters which are protected by pod disruption budgets
+// in one cluster only
+func compareWorkloadsCoverage(workloads1, workloads2 []workload, budgets1, budgets2 []pdb) bool {
+	var (
+		flag bool
+	)
+
+	mapWorkloads2 := make(map[string]workload, len(workloads2))
+	for _, w := range workloads2 {
+		mapWorkloads2[w.Kind+"/"+w.Name] = w
+	}
+
+	for _, w1 := range workloads1 {
+		w2, ok := mapWorkloads2[w1.Kind+"/"+w1.Name]
+		if !ok {
+			continue
+		}
+
+		protecting1, protecting2 := getProtectingBudgets(budgets1, w1), getProtectingBudgets(budgets2, w2)
+
+		switch {
+		case len(protecting1) > 0 && len(protecting2) == 0:
+			log.Infof("%s '%s' is protected by pod disruption budgets [%s] in 1st cluster only", w1.Kind, w1.Name, strings.Join(protecting1, ", "))
+			flag = true
+		case len(protecting1) == 0 && len(protecting2) > 0:
+			log.Infof("%s '%s' is protected by pod disruption budgets [%s] in 2nd cluster only", w1.Kind, w1.Name, strings.Join(protecting2, ", "))
+			flag = true
+		}
+	}
+
+	return flag
+}
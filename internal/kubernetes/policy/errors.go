@@ -0,0 +1,9 @@
+package policy
+
+import "errors"
+
+var (
+	ErrorDisruptionConstraintDifferent = errors.New("the disruption constraint in the pod disruption budgets is different")
+	ErrorPDBSelectorDifferent          = errors.New("the selector in the pod disruption budgets is different")
+	ErrorEvictionPolicyDifferent       = errors.New("the unhealthy pod eviction policy in the pod disruption budgets is different")
+)
@@ -0,0 +1,169 @@
+package policy
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+)
+
+const (
+	pdbGroupVersionV1      = "policy/v1"
+	pdbGroupVersionV1beta1 = "policy/v1beta1"
+
+	// noDisruptionsAllowed and anyDisruptionsAllowed are constraints which can be written either by minAvailable or by maxUnavailable
+	noDisruptionsAllowed  = "no pods may be disrupted"
+	anyDisruptionsAllowed = "any pods may be disrupted"
+)
+
+var (
+	// pdbGroupVersions lists group versions serving pod disruption budgets from the most to the least preferred one
+	pdbGroupVersions = []string{
+		pdbGroupVersionV1,
+		pdbGroupVersionV1beta1,
+	}
+)
+
+// pdbList mirrors PodDisruptionBudgetList of policy/v1 and policy/v1beta1 which share the same layout.
+// policy/v1 and the unhealthyPodEvictionPolicy field are unknown to the vendored client-go
+type pdbList struct {
+	Items []pdb `json:"items"`
+}
+
+// pdb mirrors a PodDisruptionBudget of policy/v1 and policy/v1beta1
+type pdb struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec pdbSpec `json:"spec,omitempty"`
+
+	// GroupVersion is the API version the budget is obtained with, an empty selector matches all pods in policy/v1 only
+	GroupVersion string `json:"-"`
+}
+
+// pdbSpec mirrors a PodDisruptionBudget spec extended with fields added in policy/v1
+type pdbSpec struct {
+	v1beta1.PodDisruptionBudgetSpec
+
+	UnhealthyPodEvictionPolicy *string `json:"unhealthyPodEvictionPolicy,omitempty"`
+}
+
+// getPodDisruptionBudgets returns pod disruption budgets of the namespace using the most preferred API version served by the cluster
+func getPodDisruptionBudgets(clientSet kubernetes.Interface, namespace string) ([]pdb, error) {
+	groupVersion, err := common.GetServedGroupVersion(clientSet, "poddisruptionbudgets", pdbGroupVersions...)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("pod disruption budgets are obtained using '%s' API", groupVersion)
+
+	list := pdbList{}
+
+	if err := common.GetRawResourceList(clientSet, groupVersion, namespace, "poddisruptionbudgets", &list); err != nil {
+		return nil, fmt.Errorf("cannot obtain pod disruption budgets using '%s' API: %w", groupVersion, err)
+	}
+
+	for index := range list.Items {
+		list.Items[index].GroupVersion = groupVersion
+	}
+
+	return list.Items, nil
+}
+
+// formatDisruptionConstraint returns the constraint of the budget. Budgets forbidding or allowing all disruptions are
+// equal regardless of the field used, e.g. minAvailable 100% equals maxUnavailable 0
+func formatDisruptionConstraint(spec pdbSpec) string {
+	if spec.MaxUnavailable != nil {
+		switch value := normalizeIntOrPercent(*spec.MaxUnavailable); value {
+		case "0", "0%":
+			return noDisruptionsAllowed
+		case "100%":
+			return anyDisruptionsAllowed
+		default:
+			return "maxUnavailable " + value
+		}
+	}
+
+	if spec.MinAvailable != nil {
+		switch value := normalizeIntOrPercent(*spec.MinAvailable); value {
+		case "100%":
+			return noDisruptionsAllowed
+		case "0", "0%":
+			return anyDisruptionsAllowed
+		default:
+			return "minAvailable " + value
+		}
+	}
+
+	// the API server defaults minAvailable to 1 when neither field is set
+	return "minAvailable 1"
+}
+
+// normalizeIntOrPercent returns a number or a percentage in the canonical form, e.g. "050%" is "50%" and "2" is 2
+func normalizeIntOrPercent(value intstr.IntOrString) string {
+	if value.Type == intstr.Int {
+		return strconv.Itoa(int(value.IntVal))
+	}
+
+	raw := strings.TrimSpace(value.StrVal)
+
+	if strings.HasSuffix(raw, "%") {
+		if percent, err := strconv.Atoi(strings.TrimSpace(strings.TrimSuffix(raw, "%"))); err == nil {
+			return fmt.Sprintf("%d%%", percent)
+		}
+
+		return raw
+	}
+
+	if number, err := strconv.Atoi(raw); err == nil {
+		return strconv.Itoa(number)
+	}
+
+	return raw
+}
+
+// formatPDBSelector returns the selector of the budget in the canonical form
+func formatPDBSelector(budget pdb) string {
+	if budget.Spec.Selector == nil {
+		return "<none>"
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(budget.Spec.Selector)
+	if err != nil {
+		return fmt.Sprintf("%v", *budget.Spec.Selector)
+	}
+
+	if selector.Empty() {
+		if budget.GroupVersion == pdbGroupVersionV1beta1 {
+			return "<none>"
+		}
+		return "<all>"
+	}
+
+	return selector.String()
+}
+
+// selectsPods checks whether the budget applies to pods with the given labels. An empty selector matches no pods
+// in policy/v1beta1 and all pods in policy/v1
+func (budget pdb) selectsPods(podLabels map[string]string) bool {
+	if budget.Spec.Selector == nil {
+		return false
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(budget.Spec.Selector)
+	if err != nil {
+		return false
+	}
+
+	if selector.Empty() {
+		return budget.GroupVersion != pdbGroupVersionV1beta1
+	}
+
+	return selector.Matches(labels.Set(podLabels))
+}
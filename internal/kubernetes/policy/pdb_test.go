@@ -0,0 +1,113 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"k8s-cluster-comparator/internal/logging"
+)
+
+func newBudget(name, groupVersion string, selector *metav1.LabelSelector, minAvailable, maxUnavailable *intstr.IntOrString) pdb {
+	return pdb{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: pdbSpec{PodDisruptionBudgetSpec: v1beta1.PodDisruptionBudgetSpec{
+			Selector:       selector,
+			MinAvailable:   minAvailable,
+			MaxUnavailable: maxUnavailable,
+		}},
+		GroupVersion: groupVersion,
+	}
+}
+
+func TestCompareSpecInPDBs(t *testing.T) {
+	if err := logging.Configure(false); err != nil {
+		t.Fatalf("cannot configure logging: %s", err.Error())
+	}
+	if err := Init(context.Background()); err != nil {
+		t.Fatalf("cannot init policy package: %s", err.Error())
+	}
+
+	var (
+		selector    = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "api"}}
+		allPercent  = intstr.FromString("100%")
+		zero        = intstr.FromInt(0)
+		halfPadded  = intstr.FromString("050%")
+		half        = intstr.FromString("50%")
+		two         = intstr.FromInt(2)
+		twoAsString = intstr.FromString("2")
+	)
+
+	tests := []struct {
+		name           string
+		budget1        pdb
+		budget2        pdb
+		expectedResult error
+	}{
+		{
+			name:    "minAvailable 100% equals maxUnavailable 0",
+			budget1: newBudget("api", pdbGroupVersionV1, selector, &allPercent, nil),
+			budget2: newBudget("api", pdbGroupVersionV1beta1, selector, nil, &zero),
+		},
+		{
+			name:    "percentages and numbers are normalized",
+			budget1: newBudget("api", pdbGroupVersionV1, selector, &two, &halfPadded),
+			budget2: newBudget("api", pdbGroupVersionV1, selector, &twoAsString, &half),
+		},
+		{
+			name:           "different constraints",
+			budget1:        newBudget("api", pdbGroupVersionV1, selector, &two, nil),
+			budget2:        newBudget("api", pdbGroupVersionV1, selector, nil, &half),
+			expectedResult: ErrorDisruptionConstraintDifferent,
+		},
+		{
+			name:           "empty selector matches all pods in policy/v1 only",
+			budget1:        newBudget("api", pdbGroupVersionV1, &metav1.LabelSelector{}, &two, nil),
+			budget2:        newBudget("api", pdbGroupVersionV1beta1, &metav1.LabelSelector{}, &two, nil),
+			expectedResult: ErrorPDBSelectorDifferent,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := compareSpecInPDBs(tc.budget1, tc.budget2)
+			if !errors.Is(err, tc.expectedResult) {
+				t.Errorf("expected '%v', got '%v'", tc.expectedResult, err)
+			}
+		})
+	}
+}
+
+func TestCompareWorkloadsCoverage(t *testing.T) {
+	if err := logging.Configure(false); err != nil {
+		t.Fatalf("cannot configure logging: %s", err.Error())
+	}
+	if err := Init(context.Background()); err != nil {
+		t.Fatalf("cannot init policy package: %s", err.Error())
+	}
+
+	var (
+		one       = intstr.FromInt(1)
+		workloads = []workload{
+			{Kind: "deployment", Name: "api", PodLabels: map[string]string{"app": "api"}},
+			{Kind: "statefulset", Name: "db", PodLabels: map[string]string{"app": "db"}},
+		}
+		apiBudget = newBudget("api", pdbGroupVersionV1, &metav1.LabelSelector{MatchLabels: map[string]string{"app": "api"}}, &one, nil)
+		dbBudget  = newBudget("db-budget", pdbGroupVersionV1, &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}, &one, nil)
+		dbRenamed = newBudget("database", pdbGroupVersionV1, &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: "app", Operator: metav1.LabelSelectorOpIn, Values: []string{"db", "cache"}},
+		}}, &one, nil)
+	)
+
+	if compareWorkloadsCoverage(workloads, workloads, []pdb{apiBudget, dbBudget}, []pdb{apiBudget, dbRenamed}) {
+		t.Error("Workloads protected by differently named budgets in both clusters are reported")
+	}
+
+	if !compareWorkloadsCoverage(workloads, workloads, []pdb{apiBudget, dbBudget}, []pdb{apiBudget}) {
+		t.Error("Workload protected in 1st cluster only is not reported")
+	}
+}
@@ -0,0 +1,178 @@
+package policy
+
+import (
+	"fmt"
+	"sync"
+
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+	"k8s-cluster-comparator/internal/kubernetes/kv_maps"
+	"k8s-cluster-comparator/internal/kubernetes/skipper"
+	"k8s-cluster-comparator/internal/kubernetes/types"
+)
+
+// ComparePodDisruptionBudgets compares list of pod disruption budgets objects in two given k8s-clusters and checks
+// that Deployments and StatefulSets are protected by budgets in both clusters alike
+func ComparePodDisruptionBudgets(clientSet1, clientSet2 kubernetes.Interface, namespace string, skipEntityList skipper.SkipEntitiesList) (bool, error) {
+	budgets1, err := getPodDisruptionBudgets(clientSet1, namespace)
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain pod disruption budgets from 1st cluster: %w", err)
+	}
+
+	budgets2, err := getPodDisruptionBudgets(clientSet2, namespace)
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain pod disruption budgets from 2nd cluster: %w", err)
+	}
+
+	mapBudgets1, mapBudgets2 := preparePDBMaps(budgets1, budgets2, skipEntityList.GetByKind("poddisruptionbudgets"))
+
+	isClustersDiffer := setInformationAboutPDBs(mapBudgets1, mapBudgets2, budgets1, budgets2)
+
+	workloads1, err := getWorkloads(clientSet1, namespace, skipEntityList)
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain workloads from 1st cluster: %w", err)
+	}
+
+	workloads2, err := getWorkloads(clientSet2, namespace, skipEntityList)
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain workloads from 2nd cluster: %w", err)
+	}
+
+	if compareWorkloadsCoverage(workloads1, workloads2, budgets1, budgets2) {
+		isClustersDiffer = true
+	}
+
+	return isClustersDiffer, nil
+}
+
+// preparePDBMaps add value pod disruption budgets in map
+func preparePDBMaps(budgets1, budgets2 []pdb, skipEntities skipper.SkipComponentNames) (map[string]types.IsAlreadyComparedFlag, map[string]types.IsAlreadyComparedFlag) { //nolint:gocritic,unused
+	mapBudgets1 := make(map[string]types.IsAlreadyComparedFlag)
+	mapBudgets2 := make(map[string]types.IsAlreadyComparedFlag)
+	var indexCheck types.IsAlreadyComparedFlag
+
+	for index, value := range budgets1 {
+		if skipEntities.IsSkippedEntity(value.Name) {
+			log.Debugf("pod disruption budget %s is skipped from comparison due to its name", value.Name)
+			continue
+		}
+		indexCheck.Index = index
+		mapBudgets1[value.Name] = indexCheck
+	}
+	for index, value := range budgets2 {
+		if skipEntities.IsSkippedEntity(value.Name) {
+			log.Debugf("pod disruption budget %s is skipped from comparison due to its name", value.Name)
+			continue
+		}
+		indexCheck.Index = index
+		mapBudgets2[value.Name] = indexCheck
+	}
+
+	return mapBudgets1, mapBudgets2
+}
+
+// setInformationAboutPDBs set information about pod disruption budgets
+func setInformationAboutPDBs(map1, map2 map[string]types.IsAlreadyComparedFlag, budgets1, budgets2 []pdb) bool {
+	var (
+		flag bool
+	)
+
+	if len(map1) != len(map2) {
+		log.Infof("pod disruption budget counts are different")
+		flag = true
+	}
+
+	wg := &sync.WaitGroup{}
+	channel := make(chan bool, len(map1))
+
+	for name, index1 := range map1 {
+		if index2, ok := map2[name]; ok {
+			wg.Add(1)
+
+			index1.Check = true
+			map1[name] = index1
+			index2.Check = true
+			map2[name] = index2
+
+			go comparePDBSpecInternals(wg, channel, name, &budgets1[index1.Index], &budgets2[index2.Index])
+		} else {
+			log.Infof("pod disruption budget '%s' does not exist in 2nd cluster", name)
+			flag = true
+			channel <- flag
+		}
+	}
+
+	wg.Wait()
+
+	close(channel)
+
+	for ch := range channel {
+		if ch {
+			flag = true
+		}
+	}
+	for name, index := range map2 {
+		if !index.Check {
+			log.Infof("pod disruption budget '%s' does not exist in 1st cluster", name)
+			flag = true
+		}
+	}
+
+	return flag
+}
+
+func comparePDBSpecInternals(wg *sync.WaitGroup, channel chan bool, name string, budget1, budget2 *pdb) {
+	var (
+		flag bool
+	)
+	defer func() {
+		wg.Done()
+	}()
+
+	log.Debugf("----- Start checking pod disruption budget: '%s' (%s and %s) -----", name, budget1.GroupVersion, budget2.GroupVersion)
+
+	if !kv_maps.AreKVMapsEqual(budget1.Labels, budget2.Labels, common.SkippedKubeLabels) {
+		log.Infof("metadata of pod disruption budget '%s' differs: different labels", budget1.Name)
+		channel <- true
+		return
+	}
+
+	err := compareSpecInPDBs(*budget1, *budget2)
+	if err != nil {
+		log.Infof("PodDisruptionBudget %s: %s", name, err.Error())
+		flag = true
+	}
+
+	log.Debugf("----- End checking pod disruption budget: '%s' -----", name)
+	channel <- flag
+}
+
+// compareSpecInPDBs compares the disruption constraint, the selector and the eviction policy of budgets
+func compareSpecInPDBs(budget1, budget2 pdb) error {
+	constraint1, constraint2 := formatDisruptionConstraint(budget1.Spec), formatDisruptionConstraint(budget2.Spec)
+	if constraint1 != constraint2 {
+		return fmt.Errorf("%w. First budget: '%s'. Second budget: '%s'", ErrorDisruptionConstraintDifferent, constraint1, constraint2)
+	}
+
+	selector1, selector2 := formatPDBSelector(budget1), formatPDBSelector(budget2)
+	if selector1 != selector2 {
+		return fmt.Errorf("%w. First budget: '%s'. Second budget: '%s'", ErrorPDBSelectorDifferent, selector1, selector2)
+	}
+
+	policy1, policy2 := getEvictionPolicy(budget1.Spec), getEvictionPolicy(budget2.Spec)
+	if policy1 != policy2 {
+		return fmt.Errorf("%w. First budget: '%s'. Second budget: '%s'", ErrorEvictionPolicyDifferent, policy1, policy2)
+	}
+
+	return nil
+}
+
+// getEvictionPolicy returns the unhealthy pod eviction policy of the budget, IfHealthyBudget is used when it is not set
+func getEvictionPolicy(spec pdbSpec) string {
+	if spec.UnhealthyPodEvictionPolicy == nil {
+		return "IfHealthyBudget"
+	}
+
+	return *spec.UnhealthyPodEvictionPolicy
+}
@@ -0,0 +1,12 @@
+package quotas
+
+import "errors"
+
+var (
+	ErrorQuotaHardLimitsDifferent    = errors.New("the hard limits in the resource quotas are different")
+	ErrorQuotaScopesDifferent        = errors.New("the scopes in the resource quotas are different")
+	ErrorQuotaScopeSelectorDifferent = errors.New("the scope selector in the resource quotas is different")
+
+	ErrorLimitTypesDifferent     = errors.New("the limit types in the limit ranges are different")
+	ErrorLimitsInRangesDifferent = errors.New("the limits in the limit ranges are different")
+)
@@ -0,0 +1,24 @@
+package quotas
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"k8s-cluster-comparator/internal/config"
+	"k8s-cluster-comparator/internal/logging"
+)
+
+var (
+	log *zap.SugaredLogger
+
+	showQuotaUsage bool
+)
+
+func Init(ctx context.Context) error {
+	log = logging.FromContext(ctx)
+
+	showQuotaUsage = config.FromContext(ctx).ShowQuotaUsage
+
+	return nil
+}
@@ -0,0 +1,220 @@
+package quotas
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+	"k8s-cluster-comparator/internal/kubernetes/kv_maps"
+	"k8s-cluster-comparator/internal/kubernetes/skipper"
+	"k8s-cluster-comparator/internal/kubernetes/types"
+)
+
+// CompareLimitRanges compares list of limit ranges objects in two given k8s-clusters
+func CompareLimitRanges(clientSet1, clientSet2 kubernetes.Interface, namespace string, skipEntityList skipper.SkipEntitiesList) (bool, error) {
+	limitRanges1, err := clientSet1.CoreV1().LimitRanges(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain limit ranges list from 1st cluster: %w", err)
+	}
+
+	limitRanges2, err := clientSet2.CoreV1().LimitRanges(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain limit ranges list from 2nd cluster: %w", err)
+	}
+
+	mapLimitRanges1, mapLimitRanges2 := prepareLimitRangeMaps(limitRanges1, limitRanges2, skipEntityList.GetByKind("limitranges"))
+
+	return setInformationAboutLimitRanges(mapLimitRanges1, mapLimitRanges2, limitRanges1, limitRanges2), nil
+}
+
+// prepareLimitRangeMaps add value limit ranges in map
+func prepareLimitRangeMaps(limitRanges1, limitRanges2 *v1.LimitRangeList, skipEntities skipper.SkipComponentNames) (map[string]types.IsAlreadyComparedFlag, map[string]types.IsAlreadyComparedFlag) { //nolint:gocritic,unused
+	mapLimitRanges1 := make(map[string]types.IsAlreadyComparedFlag)
+	mapLimitRanges2 := make(map[string]types.IsAlreadyComparedFlag)
+	var indexCheck types.IsAlreadyComparedFlag
+
+	for index, value := range limitRanges1.Items {
+		if skipEntities.IsSkippedEntity(value.Name) {
+			log.Debugf("limit range %s is skipped from comparison due to its name", value.Name)
+			continue
+		}
+		indexCheck.Index = index
+		mapLimitRanges1[value.Name] = indexCheck
+	}
+	for index, value := range limitRanges2.Items {
+		if skipEntities.IsSkippedEntity(value.Name) {
+			log.Debugf("limit range %s is skipped from comparison due to its name", value.Name)
+			continue
+		}
+		indexCheck.Index = index
+		mapLimitRanges2[value.Name] = indexCheck
+	}
+
+	return mapLimitRanges1, mapLimitRanges2
+}
+
+// setInformationAboutLimitRanges set information about limit ranges
+func setInformationAboutLimitRanges(map1, map2 map[string]types.IsAlreadyComparedFlag, limitRanges1, limitRanges2 *v1.LimitRangeList) bool {
+	var (
+		flag bool
+	)
+
+	if len(map1) != len(map2) {
+		log.Infof("limit range counts are different")
+		flag = true
+	}
+
+	wg := &sync.WaitGroup{}
+	channel := make(chan bool, len(map1))
+
+	for name, index1 := range map1 {
+		if index2, ok := map2[name]; ok {
+			wg.Add(1)
+
+			index1.Check = true
+			map1[name] = index1
+			index2.Check = true
+			map2[name] = index2
+
+			go compareLimitRangeSpecInternals(wg, channel, name, &limitRanges1.Items[index1.Index], &limitRanges2.Items[index2.Index])
+		} else {
+			log.Infof("limit range '%s' does not exist in 2nd cluster", name)
+			flag = true
+			channel <- flag
+		}
+	}
+
+	wg.Wait()
+
+	close(channel)
+
+	for ch := range channel {
+		if ch {
+			flag = true
+		}
+	}
+	for name, index := range map2 {
+		if !index.Check {
+			log.Infof("limit range '%s' does not exist in 1st cluster", name)
+			flag = true
+		}
+	}
+
+	return flag
+}
+
+func compareLimitRangeSpecInternals(wg *sync.WaitGroup, channel chan bool, name string, limitRange1, limitRange2 *v1.LimitRange) {
+	var (
+		flag bool
+	)
+	defer func() {
+		wg.Done()
+	}()
+
+	log.Debugf("----- Start checking limit range: '%s' -----", name)
+
+	if !kv_maps.AreKVMapsEqual(limitRange1.Labels, limitRange2.Labels, common.SkippedKubeLabels) {
+		log.Infof("metadata of limit range '%s' differs: different labels", limitRange1.Name)
+		channel <- true
+		return
+	}
+
+	err := compareSpecInLimitRanges(limitRange1.Spec, limitRange2.Spec)
+	if err != nil {
+		log.Infof("LimitRange %s: %s", name, err.Error())
+		flag = true
+	}
+
+	log.Debugf("----- End checking limit range: '%s' -----", name)
+	channel <- flag
+}
+
+// compareSpecInLimitRanges compares limits of every type regardless of the order of limit items
+func compareSpecInLimitRanges(spec1, spec2 v1.LimitRangeSpec) error {
+	limits1, limits2 := getLimitsByType(spec1.Limits), getLimitsByType(spec2.Limits)
+
+	types1, types2 := getSortedLimitTypes(limits1), getSortedLimitTypes(limits2)
+	if strings.Join(types1, ", ") != strings.Join(types2, ", ") {
+		return fmt.Errorf("%w. First limit range: [%s]. Second limit range: [%s]", ErrorLimitTypesDifferent, strings.Join(types1, ", "), strings.Join(types2, ", "))
+	}
+
+	differences := make([]string, 0)
+
+	for _, limitType := range types1 {
+		item1, item2 := limits1[v1.LimitType(limitType)], limits2[v1.LimitType(limitType)]
+
+		for _, field := range []struct {
+			name         string
+			list1, list2 v1.ResourceList
+		}{
+			{name: "max", list1: item1.Max, list2: item2.Max},
+			{name: "min", list1: item1.Min, list2: item2.Min},
+			{name: "default", list1: item1.Default, list2: item2.Default},
+			{name: "defaultRequest", list1: item1.DefaultRequest, list2: item2.DefaultRequest},
+			{name: "maxLimitRequestRatio", list1: item1.MaxLimitRequestRatio, list2: item2.MaxLimitRequestRatio},
+		} {
+			for _, difference := range compareResourceLists(field.list1, field.list2) {
+				differences = append(differences, fmt.Sprintf("%s %s %s", limitType, field.name, difference))
+			}
+		}
+	}
+
+	if len(differences) > 0 {
+		return fmt.Errorf("%w: %s", ErrorLimitsInRangesDifferent, strings.Join(differences, "; "))
+	}
+
+	return nil
+}
+
+// getLimitsByType returns limit items by their types. Resource lists of several items of the same type are merged
+func getLimitsByType(items []v1.LimitRangeItem) map[v1.LimitType]v1.LimitRangeItem {
+	limits := make(map[v1.LimitType]v1.LimitRangeItem, len(items))
+
+	for _, item := range items {
+		merged, ok := limits[item.Type]
+		if !ok {
+			limits[item.Type] = item
+			continue
+		}
+
+		merged.Max = mergeResourceLists(merged.Max, item.Max)
+		merged.Min = mergeResourceLists(merged.Min, item.Min)
+		merged.Default = mergeResourceLists(merged.Default, item.Default)
+		merged.DefaultRequest = mergeResourceLists(merged.DefaultRequest, item.DefaultRequest)
+		merged.MaxLimitRequestRatio = mergeResourceLists(merged.MaxLimitRequestRatio, item.MaxLimitRequestRatio)
+		limits[item.Type] = merged
+	}
+
+	return limits
+}
+
+// mergeResourceLists returns a union of resource lists, values of the second list take precedence
+func mergeResourceLists(list1, list2 v1.ResourceList) v1.ResourceList {
+	merged := make(v1.ResourceList, len(list1)+len(list2))
+
+	for name, quantity := range list1 {
+		merged[name] = quantity
+	}
+	for name, quantity := range list2 {
+		merged[name] = quantity
+	}
+
+	return merged
+}
+
+// getSortedLimitTypes returns sorted limit types
+func getSortedLimitTypes(limits map[v1.LimitType]v1.LimitRangeItem) []string {
+	limitTypes := make([]string, 0, len(limits))
+	for limitType := range limits {
+		limitTypes = append(limitTypes, string(limitType))
+	}
+	sort.Strings(limitTypes)
+
+	return limitTypes
+}
@@ -0,0 +1,106 @@
+package quotas
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s-cluster-comparator/internal/logging"
+)
+
+func newResourceQuota(hard v1.ResourceList, scopes ...v1.ResourceQuotaScope) *v1.ResourceQuota {
+	return &v1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "compute", Namespace: "default"},
+		Spec:       v1.ResourceQuotaSpec{Hard: hard, Scopes: scopes},
+		Status:     v1.ResourceQuotaStatus{Hard: hard, Used: v1.ResourceList{v1.ResourceRequestsCPU: resource.MustParse("1500m")}},
+	}
+}
+
+func TestCompareResourceQuotas(t *testing.T) {
+	if err := logging.Configure(false); err != nil {
+		t.Fatalf("cannot configure logging: %s", err.Error())
+	}
+	if err := Init(context.Background()); err != nil {
+		t.Fatalf("cannot init quotas package: %s", err.Error())
+	}
+
+	showQuotaUsage = true
+	defer func() {
+		showQuotaUsage = false
+	}()
+
+	quota1 := newResourceQuota(v1.ResourceList{
+		v1.ResourceRequestsCPU:    resource.MustParse("4"),
+		v1.ResourceRequestsMemory: resource.MustParse("8Gi"),
+	}, v1.ResourceQuotaScopeNotBestEffort, v1.ResourceQuotaScopeNotTerminating)
+	quota2 := newResourceQuota(v1.ResourceList{
+		v1.ResourceRequestsCPU:    resource.MustParse("4000m"),
+		v1.ResourceRequestsMemory: resource.MustParse("8192Mi"),
+	}, v1.ResourceQuotaScopeNotTerminating, v1.ResourceQuotaScopeNotBestEffort)
+
+	isDiffer, err := CompareResourceQuotas(fake.NewSimpleClientset(quota1), fake.NewSimpleClientset(quota2), "default", nil)
+	if err != nil {
+		t.Fatalf("cannot compare resource quotas: %s", err.Error())
+	}
+	if isDiffer {
+		t.Error("Equal resource quotas written differently are reported as different")
+	}
+
+	quota2.Spec.Hard[v1.ResourceRequestsMemory] = resource.MustParse("16Gi")
+	quota2.Spec.Hard[v1.ResourcePods] = resource.MustParse("10")
+
+	err = compareSpecInResourceQuotas(quota1.Spec, quota2.Spec)
+	if !errors.Is(err, ErrorQuotaHardLimitsDifferent) {
+		t.Fatalf("Different hard limits are not reported: %v", err)
+	}
+	if !strings.Contains(err.Error(), "pods: 10 in 2nd cluster only; requests.memory: 8Gi and 16Gi") {
+		t.Errorf("Different hard limits are reported imprecisely: %s", err.Error())
+	}
+
+	usage := formatResourceQuotaUsage(quota1.Name, quota1.Status, quota2.Status)
+	if len(usage) != 3 || !strings.Contains(usage[0], "usage of pods: not limited in 1st cluster, 0/10 in 2nd cluster") {
+		t.Errorf("Quota usage is reported imprecisely: %v", usage)
+	}
+}
+
+func TestCompareSpecInLimitRanges(t *testing.T) {
+	containerLimits := v1.LimitRangeItem{
+		Type:           v1.LimitTypeContainer,
+		Default:        v1.ResourceList{v1.ResourceCPU: resource.MustParse("500m"), v1.ResourceMemory: resource.MustParse("512Mi")},
+		DefaultRequest: v1.ResourceList{v1.ResourceCPU: resource.MustParse("100m")},
+	}
+	pvcLimits := v1.LimitRangeItem{
+		Type: v1.LimitTypePersistentVolumeClaim,
+		Max:  v1.ResourceList{v1.ResourceStorage: resource.MustParse("10Gi")},
+	}
+
+	spec1 := v1.LimitRangeSpec{Limits: []v1.LimitRangeItem{containerLimits, pvcLimits}}
+	spec2 := v1.LimitRangeSpec{Limits: []v1.LimitRangeItem{pvcLimits, containerLimits}}
+
+	if err := compareSpecInLimitRanges(spec1, spec2); err != nil {
+		t.Errorf("Equal limit ranges are reported as different: %s", err.Error())
+	}
+
+	changedLimits := *containerLimits.DeepCopy()
+	changedLimits.Default[v1.ResourceMemory] = resource.MustParse("1Gi")
+	spec2.Limits[1] = changedLimits
+
+	err := compareSpecInLimitRanges(spec1, spec2)
+	if !errors.Is(err, ErrorLimitsInRangesDifferent) {
+		t.Fatalf("Different default limits are not reported: %v", err)
+	}
+	if !strings.Contains(err.Error(), "Container default memory: 512Mi and 1Gi") {
+		t.Errorf("Different default limits are reported imprecisely: %s", err.Error())
+	}
+
+	spec2.Limits = spec2.Limits[1:]
+	if err := compareSpecInLimitRanges(spec1, spec2); !errors.Is(err, ErrorLimitTypesDifferent) {
+		t.Errorf("Missing limit type is not reported: %v", err)
+	}
+}
@@ -0,0 +1,241 @@
+package quotas
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+	"k8s-cluster-comparator/internal/kubernetes/kv_maps"
+	"k8s-cluster-comparator/internal/kubernetes/skipper"
+	"k8s-cluster-comparator/internal/kubernetes/types"
+)
+
+// CompareResourceQuotas compares list of resource quotas objects in two given k8s-clusters
+func CompareResourceQuotas(clientSet1, clientSet2 kubernetes.Interface, namespace string, skipEntityList skipper.SkipEntitiesList) (bool, error) {
+	quotas1, err := clientSet1.CoreV1().ResourceQuotas(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain resource quotas list from 1st cluster: %w", err)
+	}
+
+	quotas2, err := clientSet2.CoreV1().ResourceQuotas(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain resource quotas list from 2nd cluster: %w", err)
+	}
+
+	mapQuotas1, mapQuotas2 := prepareResourceQuotaMaps(quotas1, quotas2, skipEntityList.GetByKind("resourcequotas"))
+
+	return setInformationAboutResourceQuotas(mapQuotas1, mapQuotas2, quotas1, quotas2), nil
+}
+
+// prepareResourceQuotaMaps add value resource quotas in map
+func prepareResourceQuotaMaps(quotas1, quotas2 *v1.ResourceQuotaList, skipEntities skipper.SkipComponentNames) (map[string]types.IsAlreadyComparedFlag, map[string]types.IsAlreadyComparedFlag) { //nolint:gocritic,unused
+	mapQuotas1 := make(map[string]types.IsAlreadyComparedFlag)
+	mapQuotas2 := make(map[string]types.IsAlreadyComparedFlag)
+	var indexCheck types.IsAlreadyComparedFlag
+
+	for index, value := range quotas1.Items {
+		if skipEntities.IsSkippedEntity(value.Name) {
+			log.Debugf("resource quota %s is skipped from comparison due to its name", value.Name)
+			continue
+		}
+		indexCheck.Index = index
+		mapQuotas1[value.Name] = indexCheck
+	}
+	for index, value := range quotas2.Items {
+		if skipEntities.IsSkippedEntity(value.Name) {
+			log.Debugf("resource quota %s is skipped from comparison due to its name", value.Name)
+			continue
+		}
+		indexCheck.Index = index
+		mapQuotas2[value.Name] = indexCheck
+	}
+
+	return mapQuotas1, mapQuotas2
+}
+
+// setInformationAboutResourceQuotas set information about resource quotas
+func setInformationAboutResourceQuotas(map1, map2 map[string]types.IsAlreadyComparedFlag, quotas1, quotas2 *v1.ResourceQuotaList) bool {
+	var (
+		flag bool
+	)
+
+	if len(map1) != len(map2) {
+		log.Infof("resource quota counts are different")
+		flag = true
+	}
+
+	wg := &sync.WaitGroup{}
+	channel := make(chan bool, len(map1))
+
+	for name, index1 := range map1 {
+		if index2, ok := map2[name]; ok {
+			wg.Add(1)
+
+			index1.Check = true
+			map1[name] = index1
+			index2.Check = true
+			map2[name] = index2
+
+			go compareResourceQuotaSpecInternals(wg, channel, name, &quotas1.Items[index1.Index], &quotas2.Items[index2.Index])
+		} else {
+			log.Infof("resource quota '%s' does not exist in 2nd cluster", name)
+			flag = true
+			channel <- flag
+		}
+	}
+
+	wg.Wait()
+
+	close(channel)
+
+	if showQuotaUsage {
+		logResourceQuotaUsage(map1, map2, quotas1, quotas2)
+	}
+
+	for ch := range channel {
+		if ch {
+			flag = true
+		}
+	}
+	for name, index := range map2 {
+		if !index.Check {
+			log.Infof("resource quota '%s' does not exist in 1st cluster", name)
+			flag = true
+		}
+	}
+
+	return flag
+}
+
+func compareResourceQuotaSpecInternals(wg *sync.WaitGroup, channel chan bool, name string, quota1, quota2 *v1.ResourceQuota) {
+	var (
+		flag bool
+	)
+	defer func() {
+		wg.Done()
+	}()
+
+	log.Debugf("----- Start checking resource quota: '%s' -----", name)
+
+	if !kv_maps.AreKVMapsEqual(quota1.Labels, quota2.Labels, common.SkippedKubeLabels) {
+		log.Infof("metadata of resource quota '%s' differs: different labels", quota1.Name)
+		channel <- true
+		return
+	}
+
+	err := compareSpecInResourceQuotas(quota1.Spec, quota2.Spec)
+	if err != nil {
+		log.Infof("ResourceQuota %s: %s", name, err.Error())
+		flag = true
+	}
+
+	log.Debugf("----- End checking resource quota: '%s' -----", name)
+	channel <- flag
+}
+
+// compareSpecInResourceQuotas compares hard limits by their quantities and scopes of resource quotas regardless of their order
+func compareSpecInResourceQuotas(spec1, spec2 v1.ResourceQuotaSpec) error {
+	if differences := compareResourceLists(spec1.Hard, spec2.Hard); len(differences) > 0 {
+		return fmt.Errorf("%w: %s", ErrorQuotaHardLimitsDifferent, strings.Join(differences, "; "))
+	}
+
+	scopes1, scopes2 := formatQuotaScopes(spec1.Scopes), formatQuotaScopes(spec2.Scopes)
+	if scopes1 != scopes2 {
+		return fmt.Errorf("%w. First quota: [%s]. Second quota: [%s]", ErrorQuotaScopesDifferent, scopes1, scopes2)
+	}
+
+	selector1, selector2 := formatScopeSelector(spec1.ScopeSelector), formatScopeSelector(spec2.ScopeSelector)
+	if selector1 != selector2 {
+		return fmt.Errorf("%w. First quota: [%s]. Second quota: [%s]", ErrorQuotaScopeSelectorDifferent, selector1, selector2)
+	}
+
+	return nil
+}
+
+// formatQuotaScopes returns sorted scopes of a resource quota
+func formatQuotaScopes(scopes []v1.ResourceQuotaScope) string {
+	values := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		values = append(values, string(scope))
+	}
+	sort.Strings(values)
+
+	return strings.Join(values, ", ")
+}
+
+// formatScopeSelector returns requirements of the scope selector regardless of their order and the order of their values
+func formatScopeSelector(selector *v1.ScopeSelector) string {
+	if selector == nil {
+		return ""
+	}
+
+	requirements := make([]string, 0, len(selector.MatchExpressions))
+	for _, expression := range selector.MatchExpressions {
+		values := append([]string{}, expression.Values...)
+		sort.Strings(values)
+
+		requirements = append(requirements, fmt.Sprintf("%s %s (%s)", expression.ScopeName, expression.Operator, strings.Join(values, ", ")))
+	}
+	sort.Strings(requirements)
+
+	return strings.Join(requirements, "; ")
+}
+
+// logResourceQuotaUsage prints the current usage of resources of quotas present in both clusters side by side, ordered by quota name
+func logResourceQuotaUsage(map1, map2 map[string]types.IsAlreadyComparedFlag, quotas1, quotas2 *v1.ResourceQuotaList) {
+	names := make([]string, 0, len(map1))
+	for name := range map1 {
+		if _, ok := map2[name]; ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, line := range formatResourceQuotaUsage(name, quotas1.Items[map1[name].Index].Status, quotas2.Items[map2[name].Index].Status) {
+			log.Info(line)
+		}
+	}
+}
+
+// formatResourceQuotaUsage returns lines describing the current usage of quota resources of both clusters side by side
+func formatResourceQuotaUsage(name string, status1, status2 v1.ResourceQuotaStatus) []string {
+	resources := make([]string, 0, len(status1.Hard)+len(status2.Hard))
+	seen := make(map[v1.ResourceName]struct{})
+
+	for _, hard := range []v1.ResourceList{status1.Hard, status2.Hard} {
+		for resourceName := range hard {
+			if _, ok := seen[resourceName]; !ok {
+				seen[resourceName] = struct{}{}
+				resources = append(resources, string(resourceName))
+			}
+		}
+	}
+	sort.Strings(resources)
+
+	lines := make([]string, 0, len(resources))
+	for _, resourceName := range resources {
+		lines = append(lines, fmt.Sprintf("resource quota '%s' usage of %s: %s in 1st cluster, %s in 2nd cluster", name, resourceName,
+			formatQuotaUsage(status1, v1.ResourceName(resourceName)), formatQuotaUsage(status2, v1.ResourceName(resourceName))))
+	}
+
+	return lines
+}
+
+// formatQuotaUsage returns used and hard values of the quota resource
+func formatQuotaUsage(status v1.ResourceQuotaStatus, resourceName v1.ResourceName) string {
+	hard, ok := status.Hard[resourceName]
+	if !ok {
+		return "not limited"
+	}
+
+	used := status.Used[resourceName]
+
+	return fmt.Sprintf("%s/%s", used.String(), hard.String())
+}
@@ -0,0 +1,36 @@
+package quotas
+
+import (
+	"fmt"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// compareResourceLists returns sorted differences of resource lists. Quantities are compared by their values,
+// e.g. 1Gi equals 1024Mi and 1 equals 1000m
+func compareResourceLists(list1, list2 v1.ResourceList) []string {
+	differences := make([]string, 0)
+
+	for name, quantity1 := range list1 {
+		quantity2, ok := list2[name]
+		if !ok {
+			differences = append(differences, fmt.Sprintf("%s: %s in 1st cluster only", name, quantity1.String()))
+			continue
+		}
+
+		if quantity1.Cmp(quantity2) != 0 {
+			differences = append(differences, fmt.Sprintf("%s: %s and %s", name, quantity1.String(), quantity2.String()))
+		}
+	}
+
+	for name, quantity2 := range list2 {
+		if _, ok := list1[name]; !ok {
+			differences = append(differences, fmt.Sprintf("%s: %s in 2nd cluster only", name, quantity2.String()))
+		}
+	}
+
+	sort.Strings(differences)
+
+	return differences
+}
@@ -0,0 +1,246 @@
+package rbac
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	v1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+	"k8s-cluster-comparator/internal/kubernetes/kv_maps"
+	"k8s-cluster-comparator/internal/kubernetes/skipper"
+	"k8s-cluster-comparator/internal/kubernetes/types"
+)
+
+// bindingModel is a common representation of RoleBindings and ClusterRoleBindings used for comparison
+type bindingModel struct {
+	Kind      string
+	Name      string
+	Namespace string
+
+	Labels map[string]string
+
+	RoleRef  v1.RoleRef
+	Subjects []v1.Subject
+}
+
+// CompareRoleBindings compares list of role bindings objects in two given k8s-clusters
+func CompareRoleBindings(clientSet1, clientSet2 kubernetes.Interface, namespace string, skipEntityList skipper.SkipEntitiesList) (bool, error) {
+	roleBindings1, err := clientSet1.RbacV1().RoleBindings(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain role bindings list from 1st cluster: %w", err)
+	}
+
+	roleBindings2, err := clientSet2.RbacV1().RoleBindings(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain role bindings list from 2nd cluster: %w", err)
+	}
+
+	models1, models2 := bindingModelsFromRoleBindings(roleBindings1), bindingModelsFromRoleBindings(roleBindings2)
+
+	mapBindings1, mapBindings2 := prepareBindingMaps(models1, models2, skipEntityList.GetByKind("rolebindings"))
+
+	return setInformationAboutBindings(mapBindings1, mapBindings2, models1, models2), nil
+}
+
+// CompareClusterRoleBindings compares list of cluster role bindings objects in two given k8s-clusters. Default bindings are skipped
+func CompareClusterRoleBindings(clientSet1, clientSet2 kubernetes.Interface, skipEntityList skipper.SkipEntitiesList) (bool, error) {
+	clusterRoleBindings1, err := clientSet1.RbacV1().ClusterRoleBindings().List(metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain cluster role bindings list from 1st cluster: %w", err)
+	}
+
+	clusterRoleBindings2, err := clientSet2.RbacV1().ClusterRoleBindings().List(metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain cluster role bindings list from 2nd cluster: %w", err)
+	}
+
+	models1, models2 := bindingModelsFromClusterRoleBindings(clusterRoleBindings1), bindingModelsFromClusterRoleBindings(clusterRoleBindings2)
+
+	mapBindings1, mapBindings2 := prepareBindingMaps(models1, models2, skipEntityList.GetByKind("clusterrolebindings"))
+
+	return setInformationAboutBindings(mapBindings1, mapBindings2, models1, models2), nil
+}
+
+// bindingModelsFromRoleBindings converts role bindings to the common model
+func bindingModelsFromRoleBindings(roleBindings *v1.RoleBindingList) []bindingModel {
+	models := make([]bindingModel, 0, len(roleBindings.Items))
+
+	for _, binding := range roleBindings.Items {
+		models = append(models, bindingModel{
+			Kind:      "role binding",
+			Name:      binding.Name,
+			Namespace: binding.Namespace,
+			Labels:    binding.Labels,
+			RoleRef:   binding.RoleRef,
+			Subjects:  binding.Subjects,
+		})
+	}
+
+	return models
+}
+
+// bindingModelsFromClusterRoleBindings converts cluster role bindings to the common model
+func bindingModelsFromClusterRoleBindings(clusterRoleBindings *v1.ClusterRoleBindingList) []bindingModel {
+	models := make([]bindingModel, 0, len(clusterRoleBindings.Items))
+
+	for _, binding := range clusterRoleBindings.Items {
+		models = append(models, bindingModel{
+			Kind:     "cluster role binding",
+			Name:     binding.Name,
+			Labels:   binding.Labels,
+			RoleRef:  binding.RoleRef,
+			Subjects: binding.Subjects,
+		})
+	}
+
+	return models
+}
+
+// prepareBindingMaps add value bindings in map
+func prepareBindingMaps(bindings1, bindings2 []bindingModel, skipEntities skipper.SkipComponentNames) (map[string]types.IsAlreadyComparedFlag, map[string]types.IsAlreadyComparedFlag) { //nolint:gocritic,unused
+	mapBindings1 := make(map[string]types.IsAlreadyComparedFlag)
+	mapBindings2 := make(map[string]types.IsAlreadyComparedFlag)
+	var indexCheck types.IsAlreadyComparedFlag
+
+	for index, value := range bindings1 {
+		if isSkippedRBACObject(value.Kind, value.Name, value.Labels, skipEntities) {
+			continue
+		}
+		indexCheck.Index = index
+		mapBindings1[value.Name] = indexCheck
+	}
+	for index, value := range bindings2 {
+		if isSkippedRBACObject(value.Kind, value.Name, value.Labels, skipEntities) {
+			continue
+		}
+		indexCheck.Index = index
+		mapBindings2[value.Name] = indexCheck
+	}
+
+	return mapBindings1, mapBindings2
+}
+
+// setInformationAboutBindings set information about bindings
+func setInformationAboutBindings(map1, map2 map[string]types.IsAlreadyComparedFlag, bindings1, bindings2 []bindingModel) bool {
+	var (
+		flag bool
+	)
+
+	kind := "role binding"
+	if len(bindings1) > 0 {
+		kind = bindings1[0].Kind
+	} else if len(bindings2) > 0 {
+		kind = bindings2[0].Kind
+	}
+
+	if len(map1) != len(map2) {
+		log.Infof("%ss counts are different", kind)
+		flag = true
+	}
+
+	wg := &sync.WaitGroup{}
+	channel := make(chan bool, len(map1))
+
+	for name, index1 := range map1 {
+		if index2, ok := map2[name]; ok {
+			wg.Add(1)
+
+			index1.Check = true
+			map1[name] = index1
+			index2.Check = true
+			map2[name] = index2
+
+			go compareBindingSpecInternals(wg, channel, name, &bindings1[index1.Index], &bindings2[index2.Index])
+		} else {
+			log.Infof("%s '%s' does not exist in 2nd cluster", kind, name)
+			flag = true
+			channel <- flag
+		}
+	}
+
+	wg.Wait()
+
+	close(channel)
+
+	for ch := range channel {
+		if ch {
+			flag = true
+		}
+	}
+	for name, index := range map2 {
+		if !index.Check {
+			log.Infof("%s '%s' does not exist in 1st cluster", kind, name)
+			flag = true
+		}
+	}
+
+	return flag
+}
+
+func compareBindingSpecInternals(wg *sync.WaitGroup, channel chan bool, name string, binding1, binding2 *bindingModel) {
+	var (
+		flag bool
+	)
+	defer func() {
+		wg.Done()
+	}()
+
+	log.Debugf("----- Start checking %s: '%s' -----", binding1.Kind, name)
+
+	if !kv_maps.AreKVMapsEqual(binding1.Labels, binding2.Labels, common.SkippedKubeLabels) {
+		log.Infof("metadata of %s '%s' differs: different labels", binding1.Kind, binding1.Name)
+		channel <- true
+		return
+	}
+
+	err := compareSpecInBindings(*binding1, *binding2)
+	if err != nil {
+		log.Infof("%s %s: %s", binding1.Kind, name, err.Error())
+		flag = true
+	}
+
+	log.Debugf("----- End checking %s: '%s' -----", binding1.Kind, name)
+	channel <- flag
+}
+
+// compareSpecInBindings compares role references and subject sets of bindings
+func compareSpecInBindings(binding1, binding2 bindingModel) error {
+	if binding1.RoleRef != binding2.RoleRef {
+		return fmt.Errorf("%w. First binding: '%s'. Second binding: '%s'", ErrorRoleRefDifferent, formatRoleRef(binding1.RoleRef), formatRoleRef(binding2.RoleRef))
+	}
+
+	subjects1, subjects2 := getSubjectSet(binding1), getSubjectSet(binding2)
+
+	only1, only2 := common.SubtractStringSets(subjects1, subjects2), common.SubtractStringSets(subjects2, subjects1)
+	if len(only1) > 0 || len(only2) > 0 {
+		return fmt.Errorf("%w. Only in 1st cluster: [%s]. Only in 2nd cluster: [%s]", ErrorSubjectsDifferent, strings.Join(only1, ", "), strings.Join(only2, ", "))
+	}
+
+	return nil
+}
+
+// formatRoleRef returns a human-readable representation of the role reference
+func formatRoleRef(roleRef v1.RoleRef) string {
+	return fmt.Sprintf("%s/%s", roleRef.Kind, roleRef.Name)
+}
+
+// getSubjectSet returns subjects of the binding as a set of 'kind/namespace/name' strings. Service accounts of
+// role bindings without an explicit namespace belong to the namespace of the binding
+func getSubjectSet(binding bindingModel) map[string]struct{} {
+	subjects := make(map[string]struct{}, len(binding.Subjects))
+
+	for _, subject := range binding.Subjects {
+		namespace := subject.Namespace
+		if subject.Kind == v1.ServiceAccountKind && namespace == "" {
+			namespace = binding.Namespace
+		}
+
+		subjects[fmt.Sprintf("%s/%s/%s", subject.Kind, namespace, subject.Name)] = struct{}{}
+	}
+
+	return subjects
+}
@@ -0,0 +1,210 @@
+package rbac
+
+import (
+	"fmt"
+	"sort"
+
+	v1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/skipper"
+)
+
+const (
+	allServiceAccountsGroup       = "system:serviceaccounts"
+	namespaceServiceAccountsGroup = "system:serviceaccounts:"
+	authenticatedGroup            = "system:authenticated"
+)
+
+// RBACSnapshot holds RBAC objects of a cluster required to compute effective permissions
+type RBACSnapshot struct {
+	roles               map[string][]v1.PolicyRule
+	clusterRoles        map[string]*v1.ClusterRole
+	roleBindings        []v1.RoleBinding
+	clusterRoleBindings []v1.ClusterRoleBinding
+}
+
+// CompareServiceAccountPermissions compares permissions effectively granted to every service account of the namespace
+// in two given k8s-clusters through roles, cluster roles (including aggregated ones) and their bindings in any namespace.
+// RBAC snapshots of the clusters are obtained once with GetRBACSnapshot and shared by all namespaces
+func CompareServiceAccountPermissions(clientSet1, clientSet2 kubernetes.Interface, snapshot1, snapshot2 *RBACSnapshot, namespace string, skipEntityList skipper.SkipEntitiesList) (bool, error) {
+	var (
+		isClustersDiffer bool
+	)
+
+	serviceAccounts1, err := clientSet1.CoreV1().ServiceAccounts(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain service accounts list from 1st cluster: %w", err)
+	}
+
+	serviceAccounts2, err := clientSet2.CoreV1().ServiceAccounts(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain service accounts list from 2nd cluster: %w", err)
+	}
+
+	names := make(map[string]struct{})
+	for _, serviceAccount := range serviceAccounts1.Items {
+		names[serviceAccount.Name] = struct{}{}
+	}
+	for _, serviceAccount := range serviceAccounts2.Items {
+		names[serviceAccount.Name] = struct{}{}
+	}
+
+	skipEntities := skipEntityList.GetByKind("serviceaccounts")
+
+	for name := range names {
+		if skipEntities.IsSkippedEntity(name) {
+			log.Debugf("service account %s is skipped from permissions comparison due to its name", name)
+			continue
+		}
+
+		permissions1 := snapshot1.getServiceAccountPermissions(namespace, name)
+		permissions2 := snapshot2.getServiceAccountPermissions(namespace, name)
+
+		if err := comparePermissions(ErrorPermissionsDifferent, permissions1, permissions2); err != nil {
+			log.Infof("ServiceAccount %s: %s", name, err.Error())
+			isClustersDiffer = true
+		}
+	}
+
+	return isClustersDiffer, nil
+}
+
+// GetRBACSnapshot obtains roles, cluster roles and bindings of all namespaces of the cluster
+func GetRBACSnapshot(clientSet kubernetes.Interface) (*RBACSnapshot, error) {
+	roles, err := clientSet.RbacV1().Roles(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot obtain roles list: %w", err)
+	}
+
+	clusterRoles, err := clientSet.RbacV1().ClusterRoles().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot obtain cluster roles list: %w", err)
+	}
+
+	roleBindings, err := clientSet.RbacV1().RoleBindings(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot obtain role bindings list: %w", err)
+	}
+
+	clusterRoleBindings, err := clientSet.RbacV1().ClusterRoleBindings().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot obtain cluster role bindings list: %w", err)
+	}
+
+	snapshot := &RBACSnapshot{
+		roles:               make(map[string][]v1.PolicyRule, len(roles.Items)),
+		clusterRoles:        make(map[string]*v1.ClusterRole, len(clusterRoles.Items)),
+		roleBindings:        roleBindings.Items,
+		clusterRoleBindings: clusterRoleBindings.Items,
+	}
+
+	for _, role := range roles.Items {
+		snapshot.roles[role.Namespace+"/"+role.Name] = role.Rules
+	}
+	for i := range clusterRoles.Items {
+		snapshot.clusterRoles[clusterRoles.Items[i].Name] = &clusterRoles.Items[i]
+	}
+
+	return snapshot, nil
+}
+
+// getServiceAccountPermissions returns permissions granted to the service account. Tuples are prefixed with the
+// namespace they are granted in, permissions granted by cluster role bindings are cluster-wide.
+// Default bindings created by the API server are ignored as they depend on the cluster version
+func (s *RBACSnapshot) getServiceAccountPermissions(namespace, name string) permissionSet {
+	permissions := make(permissionSet)
+
+	for _, binding := range s.roleBindings {
+		if binding.Labels[bootstrappingLabel] == bootstrappingLabelValue || !isServiceAccountBound(binding.Subjects, binding.Namespace, namespace, name) {
+			continue
+		}
+
+		scope := fmt.Sprintf("in namespace '%s': ", binding.Namespace)
+
+		switch binding.RoleRef.Kind {
+		case "Role":
+			permissions.addScoped(scope, s.roles[binding.Namespace+"/"+binding.RoleRef.Name])
+		case "ClusterRole":
+			permissions.addScoped(scope, s.getClusterRoleRules(binding.RoleRef.Name, make(map[string]struct{})))
+		}
+	}
+
+	for _, binding := range s.clusterRoleBindings {
+		if binding.Labels[bootstrappingLabel] == bootstrappingLabelValue || !isServiceAccountBound(binding.Subjects, "", namespace, name) {
+			continue
+		}
+
+		permissions.addScoped("cluster-wide: ", s.getClusterRoleRules(binding.RoleRef.Name, make(map[string]struct{})))
+	}
+
+	return permissions
+}
+
+// getClusterRoleRules returns rules of the cluster role. Rules of an aggregated cluster role are collected from the
+// cluster roles matching its selectors, so the result does not depend on whether the aggregation controller has run
+func (s *RBACSnapshot) getClusterRoleRules(name string, visited map[string]struct{}) []v1.PolicyRule {
+	clusterRole, ok := s.clusterRoles[name]
+	if !ok {
+		return nil
+	}
+
+	if _, ok := visited[name]; ok {
+		return nil
+	}
+	visited[name] = struct{}{}
+
+	rules := append([]v1.PolicyRule{}, clusterRole.Rules...)
+
+	if clusterRole.AggregationRule == nil {
+		return rules
+	}
+
+	names := make([]string, 0, len(s.clusterRoles))
+	for candidate := range s.clusterRoles {
+		names = append(names, candidate)
+	}
+	sort.Strings(names)
+
+	for i := range clusterRole.AggregationRule.ClusterRoleSelectors {
+		selector, err := metav1.LabelSelectorAsSelector(&clusterRole.AggregationRule.ClusterRoleSelectors[i])
+		if err != nil {
+			log.Debugf("cannot parse aggregation selector of cluster role %s: %s", name, err.Error())
+			continue
+		}
+
+		for _, candidate := range names {
+			if candidate != name && selector.Matches(labels.Set(s.clusterRoles[candidate].Labels)) {
+				rules = append(rules, s.getClusterRoleRules(candidate, visited)...)
+			}
+		}
+	}
+
+	return rules
+}
+
+// isServiceAccountBound checks whether the subjects of a binding include the service account directly or through
+// the groups every service account belongs to
+func isServiceAccountBound(subjects []v1.Subject, bindingNamespace, namespace, name string) bool {
+	for _, subject := range subjects {
+		switch subject.Kind {
+		case v1.ServiceAccountKind:
+			subjectNamespace := subject.Namespace
+			if subjectNamespace == "" {
+				subjectNamespace = bindingNamespace
+			}
+
+			if subject.Name == name && subjectNamespace == namespace {
+				return true
+			}
+		case v1.GroupKind:
+			if subject.Name == allServiceAccountsGroup || subject.Name == namespaceServiceAccountsGroup+namespace || subject.Name == authenticatedGroup {
+				return true
+			}
+		}
+	}
+
+	return false
+}
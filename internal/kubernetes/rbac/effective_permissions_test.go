@@ -0,0 +1,99 @@
+package rbac
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	v12 "k8s.io/api/core/v1"
+	v1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s-cluster-comparator/internal/logging"
+)
+
+func TestGetServiceAccountPermissions(t *testing.T) {
+	if err := logging.Configure(false); err != nil {
+		t.Fatalf("cannot configure logging: %s", err.Error())
+	}
+	if err := Init(context.Background()); err != nil {
+		t.Fatalf("cannot init rbac package: %s", err.Error())
+	}
+
+	var (
+		serviceAccount = &v12.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}}
+		readPods       = []v1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "list"}}}
+		subjects       = []v1.Subject{{Kind: v1.ServiceAccountKind, Name: "app", Namespace: "default"}}
+	)
+
+	// the 1st cluster grants access through a role and a role binding
+	clientSet1 := fake.NewSimpleClientset(serviceAccount,
+		&v1.Role{ObjectMeta: metav1.ObjectMeta{Name: "pod-reader", Namespace: "default"}, Rules: readPods},
+		&v1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "app-pod-reader", Namespace: "default"},
+			RoleRef:    v1.RoleRef{APIGroup: v1.GroupName, Kind: "Role", Name: "pod-reader"},
+			Subjects:   subjects,
+		},
+	)
+
+	// the 2nd cluster grants the same access through an aggregated cluster role bound in the namespace
+	clientSet2 := fake.NewSimpleClientset(serviceAccount,
+		&v1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{Name: "pod-reader-part", Labels: map[string]string{"aggregate-to-reader": "true"}},
+			Rules:      readPods,
+		},
+		&v1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{Name: "reader"},
+			AggregationRule: &v1.AggregationRule{ClusterRoleSelectors: []metav1.LabelSelector{
+				{MatchLabels: map[string]string{"aggregate-to-reader": "true"}},
+			}},
+		},
+		&v1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "app-reader", Namespace: "default"},
+			RoleRef:    v1.RoleRef{APIGroup: v1.GroupName, Kind: "ClusterRole", Name: "reader"},
+			Subjects:   subjects,
+		},
+	)
+
+	snapshot1, err := GetRBACSnapshot(clientSet1)
+	if err != nil {
+		t.Fatalf("cannot obtain RBAC objects: %s", err.Error())
+	}
+	snapshot2, err := GetRBACSnapshot(clientSet2)
+	if err != nil {
+		t.Fatalf("cannot obtain RBAC objects: %s", err.Error())
+	}
+
+	isDiffer, err := CompareServiceAccountPermissions(clientSet1, clientSet2, snapshot1, snapshot2, "default", nil)
+	if err != nil {
+		t.Fatalf("cannot compare permissions: %s", err.Error())
+	}
+	if isDiffer {
+		t.Error("Equal permissions granted through different bindings are reported as different")
+	}
+
+	_, err = clientSet2.RbacV1().ClusterRoleBindings().Create(&v1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-reader"},
+		RoleRef:    v1.RoleRef{APIGroup: v1.GroupName, Kind: "ClusterRole", Name: "reader"},
+		Subjects:   []v1.Subject{{Kind: v1.GroupKind, Name: "system:serviceaccounts:default"}},
+	})
+	if err != nil {
+		t.Fatalf("cannot create cluster role binding: %s", err.Error())
+	}
+
+	snapshot, err := GetRBACSnapshot(clientSet2)
+	if err != nil {
+		t.Fatalf("cannot obtain RBAC objects: %s", err.Error())
+	}
+
+	permissions := snapshot.getServiceAccountPermissions("default", "app")
+	if _, ok := permissions["cluster-wide: list pods"]; !ok {
+		t.Errorf("cluster-wide permission granted to the service accounts group is not found: %v", permissions)
+	}
+
+	err = comparePermissions(ErrorPermissionsDifferent, make(permissionSet), permissions)
+	if err == nil || !strings.Contains(err.Error(), "in namespace 'default': get pods") {
+		t.Error("Permissions present in one cluster only are not reported: ", err)
+	}
+}
@@ -0,0 +1,16 @@
+package rbac
+
+import "errors"
+
+var (
+	ErrorImagePullSecretsDifferent = errors.New("the image pull secrets in the service accounts are different")
+	ErrorAutomountTokenDifferent   = errors.New("the token automount in the service accounts is different")
+
+	ErrorRulesDifferent           = errors.New("the rules in the roles are different")
+	ErrorAggregationRuleDifferent = errors.New("the aggregation rule in the cluster roles is different")
+
+	ErrorRoleRefDifferent  = errors.New("the role reference in the bindings is different")
+	ErrorSubjectsDifferent = errors.New("the subjects in the bindings are different")
+
+	ErrorPermissionsDifferent = errors.New("the effective permissions of the service accounts are different")
+)
@@ -0,0 +1,118 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	v12 "k8s.io/api/core/v1"
+	v1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s-cluster-comparator/internal/logging"
+)
+
+func TestCompareSpecInRoles(t *testing.T) {
+	role1 := roleModel{Kind: "role", Name: "reader", Rules: []v1.PolicyRule{
+		{APIGroups: []string{"", "apps"}, Resources: []string{"pods", "deployments"}, Verbs: []string{"get", "list"}},
+	}}
+	role2 := roleModel{Kind: "role", Name: "reader", Rules: []v1.PolicyRule{
+		{APIGroups: []string{"apps"}, Resources: []string{"deployments", "pods"}, Verbs: []string{"list", "get"}},
+		{APIGroups: []string{""}, Resources: []string{"deployments", "pods"}, Verbs: []string{"get"}},
+		{APIGroups: []string{""}, Resources: []string{"pods", "deployments"}, Verbs: []string{"list"}},
+	}}
+
+	if err := compareSpecInRoles(role1, role2); err != nil {
+		t.Error("Roles granting the same permissions with differently split rules are reported as different: ", err)
+	}
+
+	role2.Rules = append(role2.Rules, v1.PolicyRule{APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"token"}, Verbs: []string{"get"}})
+
+	err := compareSpecInRoles(role1, role2)
+	if !errors.Is(err, ErrorRulesDifferent) {
+		t.Error("Error expected: 'the rules in the roles are different'. But it was returned: ", err)
+	} else if !strings.Contains(err.Error(), "Only in 2nd cluster: [get secrets/token]") {
+		t.Error("The extra permission is not reported: ", err)
+	}
+}
+
+func TestCompareSpecInBindings(t *testing.T) {
+	binding1 := bindingModel{
+		Kind:      "role binding",
+		Name:      "readers",
+		Namespace: "default",
+		RoleRef:   v1.RoleRef{APIGroup: v1.GroupName, Kind: "Role", Name: "reader"},
+		Subjects: []v1.Subject{
+			{Kind: v1.ServiceAccountKind, Name: "app"},
+			{Kind: v1.UserKind, APIGroup: v1.GroupName, Name: "jane"},
+		},
+	}
+	binding2 := binding1
+	binding2.Subjects = []v1.Subject{
+		{Kind: v1.UserKind, APIGroup: v1.GroupName, Name: "jane"},
+		{Kind: v1.ServiceAccountKind, Namespace: "default", Name: "app"},
+	}
+
+	if err := compareSpecInBindings(binding1, binding2); err != nil {
+		t.Error("Bindings with the same subjects in a different order are reported as different: ", err)
+	}
+
+	binding2.RoleRef.Kind = "ClusterRole"
+	if err := compareSpecInBindings(binding1, binding2); !errors.Is(err, ErrorRoleRefDifferent) {
+		t.Error("Error expected: 'the role reference in the bindings is different'. But it was returned: ", err)
+	}
+
+	binding2.RoleRef = binding1.RoleRef
+	binding2.Subjects = binding2.Subjects[1:]
+	if err := compareSpecInBindings(binding1, binding2); !errors.Is(err, ErrorSubjectsDifferent) {
+		t.Error("Error expected: 'the subjects in the bindings are different'. But it was returned: ", err)
+	}
+}
+
+func TestCompareServiceAccounts(t *testing.T) {
+	if err := logging.Configure(false); err != nil {
+		t.Fatalf("cannot configure logging: %s", err.Error())
+	}
+	if err := Init(context.Background()); err != nil {
+		t.Fatalf("cannot init rbac package: %s", err.Error())
+	}
+
+	automount := false
+
+	newServiceAccount := func(token string, pullSecrets ...string) *v12.ServiceAccount {
+		serviceAccount := &v12.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+			Secrets:    []v12.ObjectReference{{Name: token}},
+		}
+		for _, secret := range pullSecrets {
+			serviceAccount.ImagePullSecrets = append(serviceAccount.ImagePullSecrets, v12.LocalObjectReference{Name: secret})
+		}
+
+		return serviceAccount
+	}
+
+	clientSet1 := fake.NewSimpleClientset(newServiceAccount("app-token-abcde", "registry", "mirror"))
+	clientSet2 := fake.NewSimpleClientset(newServiceAccount("app-token-fghij", "mirror", "registry"))
+
+	isDiffer, err := CompareServiceAccounts(clientSet1, clientSet2, "default", nil)
+	if err != nil {
+		t.Fatalf("cannot compare service accounts: %s", err.Error())
+	}
+	if isDiffer {
+		t.Error("Service accounts differing in generated token secrets only are reported as different")
+	}
+
+	serviceAccount := newServiceAccount("app-token-fghij", "mirror", "registry")
+	serviceAccount.AutomountServiceAccountToken = &automount
+	clientSet2 = fake.NewSimpleClientset(serviceAccount)
+
+	isDiffer, err = CompareServiceAccounts(clientSet1, clientSet2, "default", nil)
+	if err != nil {
+		t.Fatalf("cannot compare service accounts: %s", err.Error())
+	}
+	if !isDiffer {
+		t.Error("Service accounts with different token automount are not reported")
+	}
+}
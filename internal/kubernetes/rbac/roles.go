@@ -0,0 +1,252 @@
+package rbac
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	v1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+	"k8s-cluster-comparator/internal/kubernetes/kv_maps"
+	"k8s-cluster-comparator/internal/kubernetes/skipper"
+	"k8s-cluster-comparator/internal/kubernetes/types"
+)
+
+const (
+	// bootstrappingLabel marks default roles and bindings created by the API server, they depend on the cluster version
+	bootstrappingLabel      = "kubernetes.io/bootstrapping"
+	bootstrappingLabelValue = "rbac-defaults"
+)
+
+// roleModel is a common representation of Roles and ClusterRoles used for comparison
+type roleModel struct {
+	Kind string
+	Name string
+
+	Labels map[string]string
+
+	Rules           []v1.PolicyRule
+	AggregationRule *v1.AggregationRule
+}
+
+// CompareRoles compares list of roles objects in two given k8s-clusters
+func CompareRoles(clientSet1, clientSet2 kubernetes.Interface, namespace string, skipEntityList skipper.SkipEntitiesList) (bool, error) {
+	roles1, err := clientSet1.RbacV1().Roles(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain roles list from 1st cluster: %w", err)
+	}
+
+	roles2, err := clientSet2.RbacV1().Roles(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain roles list from 2nd cluster: %w", err)
+	}
+
+	models1, models2 := roleModelsFromRoles(roles1), roleModelsFromRoles(roles2)
+
+	mapRoles1, mapRoles2 := prepareRoleMaps(models1, models2, skipEntityList.GetByKind("roles"))
+
+	return setInformationAboutRoles(mapRoles1, mapRoles2, models1, models2), nil
+}
+
+// CompareClusterRoles compares list of cluster roles objects in two given k8s-clusters. Default cluster roles are skipped
+func CompareClusterRoles(clientSet1, clientSet2 kubernetes.Interface, skipEntityList skipper.SkipEntitiesList) (bool, error) {
+	clusterRoles1, err := clientSet1.RbacV1().ClusterRoles().List(metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain cluster roles list from 1st cluster: %w", err)
+	}
+
+	clusterRoles2, err := clientSet2.RbacV1().ClusterRoles().List(metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain cluster roles list from 2nd cluster: %w", err)
+	}
+
+	models1, models2 := roleModelsFromClusterRoles(clusterRoles1), roleModelsFromClusterRoles(clusterRoles2)
+
+	mapRoles1, mapRoles2 := prepareRoleMaps(models1, models2, skipEntityList.GetByKind("clusterroles"))
+
+	return setInformationAboutRoles(mapRoles1, mapRoles2, models1, models2), nil
+}
+
+// roleModelsFromRoles converts roles to the common model
+func roleModelsFromRoles(roles *v1.RoleList) []roleModel {
+	models := make([]roleModel, 0, len(roles.Items))
+
+	for _, role := range roles.Items {
+		models = append(models, roleModel{
+			Kind:   "role",
+			Name:   role.Name,
+			Labels: role.Labels,
+			Rules:  role.Rules,
+		})
+	}
+
+	return models
+}
+
+// roleModelsFromClusterRoles converts cluster roles to the common model
+func roleModelsFromClusterRoles(clusterRoles *v1.ClusterRoleList) []roleModel {
+	models := make([]roleModel, 0, len(clusterRoles.Items))
+
+	for _, clusterRole := range clusterRoles.Items {
+		models = append(models, roleModel{
+			Kind:            "cluster role",
+			Name:            clusterRole.Name,
+			Labels:          clusterRole.Labels,
+			Rules:           clusterRole.Rules,
+			AggregationRule: clusterRole.AggregationRule,
+		})
+	}
+
+	return models
+}
+
+// prepareRoleMaps add value roles in map
+func prepareRoleMaps(roles1, roles2 []roleModel, skipEntities skipper.SkipComponentNames) (map[string]types.IsAlreadyComparedFlag, map[string]types.IsAlreadyComparedFlag) { //nolint:gocritic,unused
+	mapRoles1 := make(map[string]types.IsAlreadyComparedFlag)
+	mapRoles2 := make(map[string]types.IsAlreadyComparedFlag)
+	var indexCheck types.IsAlreadyComparedFlag
+
+	for index, value := range roles1 {
+		if isSkippedRBACObject(value.Kind, value.Name, value.Labels, skipEntities) {
+			continue
+		}
+		indexCheck.Index = index
+		mapRoles1[value.Name] = indexCheck
+	}
+	for index, value := range roles2 {
+		if isSkippedRBACObject(value.Kind, value.Name, value.Labels, skipEntities) {
+			continue
+		}
+		indexCheck.Index = index
+		mapRoles2[value.Name] = indexCheck
+	}
+
+	return mapRoles1, mapRoles2
+}
+
+// isSkippedRBACObject checks whether the object is skipped by its name or is a default one created by the API server
+func isSkippedRBACObject(kind, name string, labels map[string]string, skipEntities skipper.SkipComponentNames) bool {
+	if skipEntities.IsSkippedEntity(name) {
+		log.Debugf("%s %s is skipped from comparison due to its name", kind, name)
+		return true
+	}
+
+	if labels[bootstrappingLabel] == bootstrappingLabelValue {
+		log.Debugf("%s %s is skipped from comparison as a default one", kind, name)
+		return true
+	}
+
+	return false
+}
+
+// setInformationAboutRoles set information about roles
+func setInformationAboutRoles(map1, map2 map[string]types.IsAlreadyComparedFlag, roles1, roles2 []roleModel) bool {
+	var (
+		flag bool
+	)
+
+	kind := "role"
+	if len(roles1) > 0 {
+		kind = roles1[0].Kind
+	} else if len(roles2) > 0 {
+		kind = roles2[0].Kind
+	}
+
+	if len(map1) != len(map2) {
+		log.Infof("%ss counts are different", kind)
+		flag = true
+	}
+
+	wg := &sync.WaitGroup{}
+	channel := make(chan bool, len(map1))
+
+	for name, index1 := range map1 {
+		if index2, ok := map2[name]; ok {
+			wg.Add(1)
+
+			index1.Check = true
+			map1[name] = index1
+			index2.Check = true
+			map2[name] = index2
+
+			go compareRoleSpecInternals(wg, channel, name, &roles1[index1.Index], &roles2[index2.Index])
+		} else {
+			log.Infof("%s '%s' does not exist in 2nd cluster", kind, name)
+			flag = true
+			channel <- flag
+		}
+	}
+
+	wg.Wait()
+
+	close(channel)
+
+	for ch := range channel {
+		if ch {
+			flag = true
+		}
+	}
+	for name, index := range map2 {
+		if !index.Check {
+			log.Infof("%s '%s' does not exist in 1st cluster", kind, name)
+			flag = true
+		}
+	}
+
+	return flag
+}
+
+func compareRoleSpecInternals(wg *sync.WaitGroup, channel chan bool, name string, role1, role2 *roleModel) {
+	var (
+		flag bool
+	)
+	defer func() {
+		wg.Done()
+	}()
+
+	log.Debugf("----- Start checking %s: '%s' -----", role1.Kind, name)
+
+	if !kv_maps.AreKVMapsEqual(role1.Labels, role2.Labels, common.SkippedKubeLabels) {
+		log.Infof("metadata of %s '%s' differs: different labels", role1.Kind, role1.Name)
+		channel <- true
+		return
+	}
+
+	err := compareSpecInRoles(*role1, *role2)
+	if err != nil {
+		log.Infof("%s %s: %s", role1.Kind, name, err.Error())
+		flag = true
+	}
+
+	log.Debugf("----- End checking %s: '%s' -----", role1.Kind, name)
+	channel <- flag
+}
+
+// compareSpecInRoles compares rules of roles as normalized permission sets
+func compareSpecInRoles(role1, role2 roleModel) error {
+	aggregation1, aggregation2 := formatAggregationRule(role1.AggregationRule), formatAggregationRule(role2.AggregationRule)
+	if aggregation1 != aggregation2 {
+		return fmt.Errorf("%w. First role: '%s'. Second role: '%s'", ErrorAggregationRuleDifferent, aggregation1, aggregation2)
+	}
+
+	return comparePermissions(ErrorRulesDifferent, newPermissionSet(role1.Rules), newPermissionSet(role2.Rules))
+}
+
+// formatAggregationRule returns cluster role selectors of the aggregation rule in a form independent of their order
+func formatAggregationRule(rule *v1.AggregationRule) string {
+	if rule == nil {
+		return ""
+	}
+
+	selectors := make([]string, 0, len(rule.ClusterRoleSelectors))
+	for i := range rule.ClusterRoleSelectors {
+		selectors = append(selectors, metav1.FormatLabelSelector(&rule.ClusterRoleSelectors[i]))
+	}
+	sort.Strings(selectors)
+
+	return strings.Join(selectors, "; ")
+}
@@ -0,0 +1,70 @@
+package rbac
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/rbac/v1"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+)
+
+// permissionSet is a normalized set of permission tuples, e.g. 'get pods', 'list deployments.apps' or 'get /healthz'
+type permissionSet map[string]struct{}
+
+// newPermissionSet expands policy rules into a set of verb/resource/apiGroup tuples, so rules granting the same
+// permissions compare equal regardless of how they are split and ordered
+func newPermissionSet(rules []v1.PolicyRule) permissionSet {
+	permissions := make(permissionSet)
+	permissions.add(rules)
+
+	return permissions
+}
+
+// add expands policy rules into the set
+func (p permissionSet) add(rules []v1.PolicyRule) {
+	p.addScoped("", rules)
+}
+
+// addScoped expands policy rules into the set prefixing each tuple with the scope they are granted in
+func (p permissionSet) addScoped(scope string, rules []v1.PolicyRule) {
+	for _, rule := range rules {
+		for _, verb := range rule.Verbs {
+			for _, url := range rule.NonResourceURLs {
+				p[fmt.Sprintf("%s%s %s", scope, verb, url)] = struct{}{}
+			}
+
+			for _, apiGroup := range rule.APIGroups {
+				for _, resource := range rule.Resources {
+					if apiGroup != "" {
+						resource = resource + "." + apiGroup
+					}
+
+					if len(rule.ResourceNames) == 0 {
+						p[fmt.Sprintf("%s%s %s", scope, verb, resource)] = struct{}{}
+						continue
+					}
+
+					for _, resourceName := range rule.ResourceNames {
+						p[fmt.Sprintf("%s%s %s/%s", scope, verb, resource, resourceName)] = struct{}{}
+					}
+				}
+			}
+		}
+	}
+}
+
+// diff returns sorted permissions present only in the set and only in the other set
+func (p permissionSet) diff(other permissionSet) ([]string, []string) {
+	return common.SubtractStringSets(p, other), common.SubtractStringSets(other, p)
+}
+
+// comparePermissions returns an error listing permissions granted in one cluster only
+func comparePermissions(reason error, permissions1, permissions2 permissionSet) error {
+	only1, only2 := permissions1.diff(permissions2)
+	if len(only1) == 0 && len(only2) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%w. Only in 1st cluster: [%s]. Only in 2nd cluster: [%s]", reason, strings.Join(only1, ", "), strings.Join(only2, ", "))
+}
@@ -0,0 +1,170 @@
+package rbac
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	v12 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+	"k8s-cluster-comparator/internal/kubernetes/kv_maps"
+	"k8s-cluster-comparator/internal/kubernetes/skipper"
+	"k8s-cluster-comparator/internal/kubernetes/types"
+)
+
+// CompareServiceAccounts compares list of service accounts objects in two given k8s-clusters
+func CompareServiceAccounts(clientSet1, clientSet2 kubernetes.Interface, namespace string, skipEntityList skipper.SkipEntitiesList) (bool, error) {
+	var (
+		isClustersDiffer bool
+	)
+
+	serviceAccounts1, err := clientSet1.CoreV1().ServiceAccounts(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain service accounts list from 1st cluster: %w", err)
+	}
+
+	serviceAccounts2, err := clientSet2.CoreV1().ServiceAccounts(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain service accounts list from 2nd cluster: %w", err)
+	}
+
+	mapServiceAccounts1, mapServiceAccounts2 := prepareServiceAccountMaps(serviceAccounts1, serviceAccounts2, skipEntityList.GetByKind("serviceaccounts"))
+
+	isClustersDiffer = setInformationAboutServiceAccounts(mapServiceAccounts1, mapServiceAccounts2, serviceAccounts1, serviceAccounts2)
+
+	return isClustersDiffer, nil
+}
+
+// prepareServiceAccountMaps add value service accounts in map
+func prepareServiceAccountMaps(serviceAccounts1, serviceAccounts2 *v12.ServiceAccountList, skipEntities skipper.SkipComponentNames) (map[string]types.IsAlreadyComparedFlag, map[string]types.IsAlreadyComparedFlag) { //nolint:gocritic,unused
+	mapServiceAccounts1 := make(map[string]types.IsAlreadyComparedFlag)
+	mapServiceAccounts2 := make(map[string]types.IsAlreadyComparedFlag)
+	var indexCheck types.IsAlreadyComparedFlag
+
+	for index, value := range serviceAccounts1.Items {
+		if skipEntities.IsSkippedEntity(value.Name) {
+			log.Debugf("service account %s is skipped from comparison due to its name", value.Name)
+			continue
+		}
+		indexCheck.Index = index
+		mapServiceAccounts1[value.Name] = indexCheck
+	}
+	for index, value := range serviceAccounts2.Items {
+		if skipEntities.IsSkippedEntity(value.Name) {
+			log.Debugf("service account %s is skipped from comparison due to its name", value.Name)
+			continue
+		}
+		indexCheck.Index = index
+		mapServiceAccounts2[value.Name] = indexCheck
+	}
+
+	return mapServiceAccounts1, mapServiceAccounts2
+}
+
+// setInformationAboutServiceAccounts set information about service accounts
+func setInformationAboutServiceAccounts(map1, map2 map[string]types.IsAlreadyComparedFlag, serviceAccounts1, serviceAccounts2 *v12.ServiceAccountList) bool {
+	var (
+		flag bool
+	)
+
+	if len(map1) != len(map2) {
+		log.Infof("service accounts counts are different")
+		flag = true
+	}
+
+	wg := &sync.WaitGroup{}
+	channel := make(chan bool, len(map1))
+
+	for name, index1 := range map1 {
+		if index2, ok := map2[name]; ok {
+			wg.Add(1)
+
+			index1.Check = true
+			map1[name] = index1
+			index2.Check = true
+			map2[name] = index2
+
+			go compareServiceAccountSpecInternals(wg, channel, name, &serviceAccounts1.Items[index1.Index], &serviceAccounts2.Items[index2.Index])
+		} else {
+			log.Infof("service account '%s' does not exist in 2nd cluster", name)
+			flag = true
+			channel <- flag
+		}
+	}
+
+	wg.Wait()
+
+	close(channel)
+
+	for ch := range channel {
+		if ch {
+			flag = true
+		}
+	}
+	for name, index := range map2 {
+		if !index.Check {
+			log.Infof("service account '%s' does not exist in 1st cluster", name)
+			flag = true
+		}
+	}
+
+	return flag
+}
+
+func compareServiceAccountSpecInternals(wg *sync.WaitGroup, channel chan bool, name string, serviceAccount1, serviceAccount2 *v12.ServiceAccount) {
+	var (
+		flag bool
+	)
+	defer func() {
+		wg.Done()
+	}()
+
+	log.Debugf("----- Start checking service account: '%s' -----", name)
+
+	if !kv_maps.AreKVMapsEqual(serviceAccount1.ObjectMeta.Labels, serviceAccount2.ObjectMeta.Labels, common.SkippedKubeLabels) {
+		log.Infof("metadata of service account '%s' differs: different labels", serviceAccount1.Name)
+		channel <- true
+		return
+	}
+
+	err := compareSpecInServiceAccounts(*serviceAccount1, *serviceAccount2)
+	if err != nil {
+		log.Infof("ServiceAccount %s: %s", name, err.Error())
+		flag = true
+	}
+
+	log.Debugf("----- End checking service account: '%s' -----", name)
+	channel <- flag
+}
+
+// compareSpecInServiceAccounts compares image pull secrets and token automount of service accounts.
+// Token secrets are generated per cluster and are not compared
+func compareSpecInServiceAccounts(serviceAccount1, serviceAccount2 v12.ServiceAccount) error {
+	pullSecrets1, pullSecrets2 := make(map[string]struct{}), make(map[string]struct{})
+	for _, secret := range serviceAccount1.ImagePullSecrets {
+		pullSecrets1[secret.Name] = struct{}{}
+	}
+	for _, secret := range serviceAccount2.ImagePullSecrets {
+		pullSecrets2[secret.Name] = struct{}{}
+	}
+
+	only1, only2 := common.SubtractStringSets(pullSecrets1, pullSecrets2), common.SubtractStringSets(pullSecrets2, pullSecrets1)
+	if len(only1) > 0 || len(only2) > 0 {
+		return fmt.Errorf("%w. Only in 1st cluster: [%s]. Only in 2nd cluster: [%s]", ErrorImagePullSecretsDifferent, strings.Join(only1, ", "), strings.Join(only2, ", "))
+	}
+
+	automount1, automount2 := isTokenAutomounted(serviceAccount1), isTokenAutomounted(serviceAccount2)
+	if automount1 != automount2 {
+		return fmt.Errorf("%w. First service account: '%t'. Second service account: '%t'", ErrorAutomountTokenDifferent, automount1, automount2)
+	}
+
+	return nil
+}
+
+// isTokenAutomounted checks whether the service account token is mounted into pods, which is the default
+func isTokenAutomounted(serviceAccount v12.ServiceAccount) bool {
+	return serviceAccount.AutomountServiceAccountToken == nil || *serviceAccount.AutomountServiceAccountToken
+}
@@ -0,0 +1,13 @@
+package scheduling
+
+import "errors"
+
+var (
+	ErrorPriorityValueDifferent    = errors.New("the value in the priority classes is different")
+	ErrorGlobalDefaultDifferent    = errors.New("the global default in the priority classes is different")
+	ErrorPreemptionPolicyDifferent = errors.New("the preemption policy in the priority classes is different")
+
+	ErrorRuntimeHandlerDifferent    = errors.New("the handler in the runtime classes is different")
+	ErrorRuntimeOverheadDifferent   = errors.New("the pod overhead in the runtime classes is different")
+	ErrorRuntimeSchedulingDifferent = errors.New("the scheduling in the runtime classes is different")
+)
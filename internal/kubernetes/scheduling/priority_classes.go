@@ -0,0 +1,182 @@
+package scheduling
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	v12 "k8s.io/api/core/v1"
+	v1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+	"k8s-cluster-comparator/internal/kubernetes/kv_maps"
+	"k8s-cluster-comparator/internal/kubernetes/skipper"
+	"k8s-cluster-comparator/internal/kubernetes/types"
+)
+
+const (
+	// systemPriorityClassPrefix is reserved for priority classes created by the API server
+	systemPriorityClassPrefix = "system-"
+)
+
+// ComparePriorityClasses compares list of cluster-scoped priority classes objects in two given k8s-clusters.
+// Built-in system priority classes are skipped
+func ComparePriorityClasses(clientSet1, clientSet2 kubernetes.Interface, skipEntityList skipper.SkipEntitiesList) (bool, error) {
+	priorityClasses1, err := clientSet1.SchedulingV1().PriorityClasses().List(metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain priority classes list from 1st cluster: %w", err)
+	}
+
+	priorityClasses2, err := clientSet2.SchedulingV1().PriorityClasses().List(metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain priority classes list from 2nd cluster: %w", err)
+	}
+
+	mapPriorityClasses1, mapPriorityClasses2 := preparePriorityClassMaps(priorityClasses1, priorityClasses2, skipEntityList.GetByKind("priorityclasses"))
+
+	return setInformationAboutPriorityClasses(mapPriorityClasses1, mapPriorityClasses2, priorityClasses1, priorityClasses2), nil
+}
+
+// preparePriorityClassMaps add value priority classes in map
+func preparePriorityClassMaps(priorityClasses1, priorityClasses2 *v1.PriorityClassList, skipEntities skipper.SkipComponentNames) (map[string]types.IsAlreadyComparedFlag, map[string]types.IsAlreadyComparedFlag) { //nolint:gocritic,unused
+	mapPriorityClasses1 := make(map[string]types.IsAlreadyComparedFlag)
+	mapPriorityClasses2 := make(map[string]types.IsAlreadyComparedFlag)
+	var indexCheck types.IsAlreadyComparedFlag
+
+	for index, value := range priorityClasses1.Items {
+		if isSkippedPriorityClass(value.Name, skipEntities) {
+			continue
+		}
+		indexCheck.Index = index
+		mapPriorityClasses1[value.Name] = indexCheck
+	}
+	for index, value := range priorityClasses2.Items {
+		if isSkippedPriorityClass(value.Name, skipEntities) {
+			continue
+		}
+		indexCheck.Index = index
+		mapPriorityClasses2[value.Name] = indexCheck
+	}
+
+	return mapPriorityClasses1, mapPriorityClasses2
+}
+
+// isSkippedPriorityClass checks whether the priority class is skipped by its name or is a built-in one
+func isSkippedPriorityClass(name string, skipEntities skipper.SkipComponentNames) bool {
+	if skipEntities.IsSkippedEntity(name) {
+		log.Debugf("priority class %s is skipped from comparison due to its name", name)
+		return true
+	}
+
+	if strings.HasPrefix(name, systemPriorityClassPrefix) {
+		log.Debugf("priority class %s is skipped from comparison as a built-in one", name)
+		return true
+	}
+
+	return false
+}
+
+// setInformationAboutPriorityClasses set information about priority classes
+func setInformationAboutPriorityClasses(map1, map2 map[string]types.IsAlreadyComparedFlag, priorityClasses1, priorityClasses2 *v1.PriorityClassList) bool {
+	var (
+		flag bool
+	)
+
+	if len(map1) != len(map2) {
+		log.Infof("priority classes counts are different")
+		flag = true
+	}
+
+	wg := &sync.WaitGroup{}
+	channel := make(chan bool, len(map1))
+
+	for name, index1 := range map1 {
+		if index2, ok := map2[name]; ok {
+			wg.Add(1)
+
+			index1.Check = true
+			map1[name] = index1
+			index2.Check = true
+			map2[name] = index2
+
+			go comparePriorityClassSpecInternals(wg, channel, name, &priorityClasses1.Items[index1.Index], &priorityClasses2.Items[index2.Index])
+		} else {
+			log.Infof("priority class '%s' does not exist in 2nd cluster", name)
+			flag = true
+			channel <- flag
+		}
+	}
+
+	wg.Wait()
+
+	close(channel)
+
+	for ch := range channel {
+		if ch {
+			flag = true
+		}
+	}
+	for name, index := range map2 {
+		if !index.Check {
+			log.Infof("priority class '%s' does not exist in 1st cluster", name)
+			flag = true
+		}
+	}
+
+	return flag
+}
+
+func comparePriorityClassSpecInternals(wg *sync.WaitGroup, channel chan bool, name string, priorityClass1, priorityClass2 *v1.PriorityClass) {
+	var (
+		flag bool
+	)
+	defer func() {
+		wg.Done()
+	}()
+
+	log.Debugf("----- Start checking priority class: '%s' -----", name)
+
+	if !kv_maps.AreKVMapsEqual(priorityClass1.Labels, priorityClass2.Labels, common.SkippedKubeLabels) {
+		log.Infof("metadata of priority class '%s' differs: different labels", priorityClass1.Name)
+		channel <- true
+		return
+	}
+
+	err := compareSpecInPriorityClasses(*priorityClass1, *priorityClass2)
+	if err != nil {
+		log.Infof("PriorityClass %s: %s", name, err.Error())
+		flag = true
+	}
+
+	log.Debugf("----- End checking priority class: '%s' -----", name)
+	channel <- flag
+}
+
+// compareSpecInPriorityClasses compares the value, global default and preemption policy of priority classes
+func compareSpecInPriorityClasses(priorityClass1, priorityClass2 v1.PriorityClass) error {
+	if priorityClass1.Value != priorityClass2.Value {
+		return fmt.Errorf("%w. First priority class: %d. Second priority class: %d", ErrorPriorityValueDifferent, priorityClass1.Value, priorityClass2.Value)
+	}
+
+	if priorityClass1.GlobalDefault != priorityClass2.GlobalDefault {
+		return fmt.Errorf("%w. First priority class: %t. Second priority class: %t", ErrorGlobalDefaultDifferent, priorityClass1.GlobalDefault, priorityClass2.GlobalDefault)
+	}
+
+	policy1, policy2 := getPreemptionPolicy(priorityClass1), getPreemptionPolicy(priorityClass2)
+	if policy1 != policy2 {
+		return fmt.Errorf("%w. First priority class: '%s'. Second priority class: '%s'", ErrorPreemptionPolicyDifferent, policy1, policy2)
+	}
+
+	return nil
+}
+
+// getPreemptionPolicy returns the preemption policy of the priority class, PreemptLowerPriority is used when it is not set
+func getPreemptionPolicy(priorityClass v1.PriorityClass) v12.PreemptionPolicy {
+	if priorityClass.PreemptionPolicy == nil {
+		return v12.PreemptLowerPriority
+	}
+
+	return *priorityClass.PreemptionPolicy
+}
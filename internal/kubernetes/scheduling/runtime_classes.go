@@ -0,0 +1,238 @@
+package scheduling
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	v12 "k8s.io/api/core/v1"
+	"k8s.io/api/node/v1beta1"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+	"k8s-cluster-comparator/internal/kubernetes/kv_maps"
+	"k8s-cluster-comparator/internal/kubernetes/skipper"
+	"k8s-cluster-comparator/internal/kubernetes/types"
+)
+
+var (
+	// runtimeClassGroupVersions lists group versions serving runtime classes from the most to the least preferred one
+	runtimeClassGroupVersions = []string{
+		"node.k8s.io/v1",
+		"node.k8s.io/v1beta1",
+	}
+)
+
+// runtimeClassList mirrors RuntimeClassList of node.k8s.io/v1 and node.k8s.io/v1beta1 which share the same layout.
+// node.k8s.io/v1 is unknown to the vendored client-go
+type runtimeClassList struct {
+	Items []v1beta1.RuntimeClass `json:"items"`
+}
+
+// CompareRuntimeClasses compares list of cluster-scoped runtime classes objects in two given k8s-clusters
+func CompareRuntimeClasses(clientSet1, clientSet2 kubernetes.Interface, skipEntityList skipper.SkipEntitiesList) (bool, error) {
+	runtimeClasses1, err := getRuntimeClasses(clientSet1)
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain runtime classes from 1st cluster: %w", err)
+	}
+
+	runtimeClasses2, err := getRuntimeClasses(clientSet2)
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain runtime classes from 2nd cluster: %w", err)
+	}
+
+	mapRuntimeClasses1, mapRuntimeClasses2 := prepareRuntimeClassMaps(runtimeClasses1, runtimeClasses2, skipEntityList.GetByKind("runtimeclasses"))
+
+	return setInformationAboutRuntimeClasses(mapRuntimeClasses1, mapRuntimeClasses2, runtimeClasses1, runtimeClasses2), nil
+}
+
+// getRuntimeClasses returns runtime classes using the most preferred API version served by the cluster.
+// A cluster which does not serve runtime classes has none of them
+func getRuntimeClasses(clientSet kubernetes.Interface) ([]v1beta1.RuntimeClass, error) {
+	groupVersion, err := common.GetServedGroupVersion(clientSet, "runtimeclasses", runtimeClassGroupVersions...)
+	if errors.Is(err, common.ErrResourceNotServed) {
+		log.Debugf("runtime classes are not served by the cluster: %s", err.Error())
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("runtime classes are obtained using '%s' API", groupVersion)
+
+	list := runtimeClassList{}
+
+	if err := common.GetRawResourceList(clientSet, groupVersion, "", "runtimeclasses", &list); err != nil {
+		return nil, fmt.Errorf("cannot obtain runtime classes using '%s' API: %w", groupVersion, err)
+	}
+
+	return list.Items, nil
+}
+
+// prepareRuntimeClassMaps add value runtime classes in map
+func prepareRuntimeClassMaps(runtimeClasses1, runtimeClasses2 []v1beta1.RuntimeClass, skipEntities skipper.SkipComponentNames) (map[string]types.IsAlreadyComparedFlag, map[string]types.IsAlreadyComparedFlag) { //nolint:gocritic,unused
+	mapRuntimeClasses1 := make(map[string]types.IsAlreadyComparedFlag)
+	mapRuntimeClasses2 := make(map[string]types.IsAlreadyComparedFlag)
+	var indexCheck types.IsAlreadyComparedFlag
+
+	for index, value := range runtimeClasses1 {
+		if skipEntities.IsSkippedEntity(value.Name) {
+			log.Debugf("runtime class %s is skipped from comparison due to its name", value.Name)
+			continue
+		}
+		indexCheck.Index = index
+		mapRuntimeClasses1[value.Name] = indexCheck
+	}
+	for index, value := range runtimeClasses2 {
+		if skipEntities.IsSkippedEntity(value.Name) {
+			log.Debugf("runtime class %s is skipped from comparison due to its name", value.Name)
+			continue
+		}
+		indexCheck.Index = index
+		mapRuntimeClasses2[value.Name] = indexCheck
+	}
+
+	return mapRuntimeClasses1, mapRuntimeClasses2
+}
+
+// setInformationAboutRuntimeClasses set information about runtime classes
+func setInformationAboutRuntimeClasses(map1, map2 map[string]types.IsAlreadyComparedFlag, runtimeClasses1, runtimeClasses2 []v1beta1.RuntimeClass) bool {
+	var (
+		flag bool
+	)
+
+	if len(map1) != len(map2) {
+		log.Infof("runtime classes counts are different")
+		flag = true
+	}
+
+	wg := &sync.WaitGroup{}
+	channel := make(chan bool, len(map1))
+
+	for name, index1 := range map1 {
+		if index2, ok := map2[name]; ok {
+			wg.Add(1)
+
+			index1.Check = true
+			map1[name] = index1
+			index2.Check = true
+			map2[name] = index2
+
+			go compareRuntimeClassSpecInternals(wg, channel, name, &runtimeClasses1[index1.Index], &runtimeClasses2[index2.Index])
+		} else {
+			log.Infof("runtime class '%s' does not exist in 2nd cluster", name)
+			flag = true
+			channel <- flag
+		}
+	}
+
+	wg.Wait()
+
+	close(channel)
+
+	for ch := range channel {
+		if ch {
+			flag = true
+		}
+	}
+	for name, index := range map2 {
+		if !index.Check {
+			log.Infof("runtime class '%s' does not exist in 1st cluster", name)
+			flag = true
+		}
+	}
+
+	return flag
+}
+
+func compareRuntimeClassSpecInternals(wg *sync.WaitGroup, channel chan bool, name string, runtimeClass1, runtimeClass2 *v1beta1.RuntimeClass) {
+	var (
+		flag bool
+	)
+	defer func() {
+		wg.Done()
+	}()
+
+	log.Debugf("----- Start checking runtime class: '%s' -----", name)
+
+	if !kv_maps.AreKVMapsEqual(runtimeClass1.Labels, runtimeClass2.Labels, common.SkippedKubeLabels) {
+		log.Infof("metadata of runtime class '%s' differs: different labels", runtimeClass1.Name)
+		channel <- true
+		return
+	}
+
+	err := compareSpecInRuntimeClasses(*runtimeClass1, *runtimeClass2)
+	if err != nil {
+		log.Infof("RuntimeClass %s: %s", name, err.Error())
+		flag = true
+	}
+
+	log.Debugf("----- End checking runtime class: '%s' -----", name)
+	channel <- flag
+}
+
+// compareSpecInRuntimeClasses compares the handler, the pod overhead and the scheduling constraints of runtime classes
+func compareSpecInRuntimeClasses(runtimeClass1, runtimeClass2 v1beta1.RuntimeClass) error {
+	if runtimeClass1.Handler != runtimeClass2.Handler {
+		return fmt.Errorf("%w. First runtime class: '%s'. Second runtime class: '%s'", ErrorRuntimeHandlerDifferent, runtimeClass1.Handler, runtimeClass2.Handler)
+	}
+
+	overhead1, overhead2 := formatOverhead(runtimeClass1.Overhead), formatOverhead(runtimeClass2.Overhead)
+	if overhead1 != overhead2 {
+		return fmt.Errorf("%w. First runtime class: '%s'. Second runtime class: '%s'", ErrorRuntimeOverheadDifferent, overhead1, overhead2)
+	}
+
+	scheduling1, scheduling2 := formatScheduling(runtimeClass1.Scheduling), formatScheduling(runtimeClass2.Scheduling)
+	if scheduling1 != scheduling2 {
+		return fmt.Errorf("%w. First runtime class: '%s'. Second runtime class: '%s'", ErrorRuntimeSchedulingDifferent, scheduling1, scheduling2)
+	}
+
+	return nil
+}
+
+// formatOverhead returns the fixed pod overhead with quantities in the canonical form, e.g. 1000m and 1 are equal
+func formatOverhead(overhead *v1beta1.Overhead) string {
+	if overhead == nil || len(overhead.PodFixed) == 0 {
+		return "<none>"
+	}
+
+	resources := make([]string, 0, len(overhead.PodFixed))
+	for name, quantity := range overhead.PodFixed {
+		resources = append(resources, fmt.Sprintf("%s=%s", name, quantity.String()))
+	}
+	sort.Strings(resources)
+
+	return strings.Join(resources, ",")
+}
+
+// formatScheduling returns the node selector and tolerations of the runtime class regardless of their order
+func formatScheduling(scheduling *v1beta1.Scheduling) string {
+	if scheduling == nil || (len(scheduling.NodeSelector) == 0 && len(scheduling.Tolerations) == 0) {
+		return "<none>"
+	}
+
+	tolerations := make([]string, 0, len(scheduling.Tolerations))
+	for _, toleration := range scheduling.Tolerations {
+		tolerations = append(tolerations, formatToleration(toleration))
+	}
+	sort.Strings(tolerations)
+
+	return fmt.Sprintf("node selector {%s}, tolerations [%s]", common.ConvertMatchLabelsToString(scheduling.NodeSelector), strings.Join(tolerations, ", "))
+}
+
+// formatToleration returns a human-readable representation of the toleration, the operator defaults to Equal
+func formatToleration(toleration v12.Toleration) string {
+	operator := toleration.Operator
+	if operator == "" {
+		operator = v12.TolerationOpEqual
+	}
+
+	result := fmt.Sprintf("%s %s %s:%s", toleration.Key, operator, toleration.Value, toleration.Effect)
+	if toleration.TolerationSeconds != nil {
+		result += fmt.Sprintf(" for %ds", *toleration.TolerationSeconds)
+	}
+
+	return result
+}
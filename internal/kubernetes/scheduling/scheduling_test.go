@@ -0,0 +1,110 @@
+package scheduling
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	v12 "k8s.io/api/core/v1"
+	"k8s.io/api/node/v1beta1"
+	v1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s-cluster-comparator/internal/logging"
+)
+
+func TestComparePriorityClasses(t *testing.T) {
+	if err := logging.Configure(false); err != nil {
+		t.Fatalf("cannot configure logging: %s", err.Error())
+	}
+	if err := Init(context.Background()); err != nil {
+		t.Fatalf("cannot init scheduling package: %s", err.Error())
+	}
+
+	newPriorityClass := func(name string, value int32) *v1.PriorityClass {
+		return &v1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: name}, Value: value}
+	}
+
+	preemptLowerPriority := v12.PreemptLowerPriority
+	explicit := newPriorityClass("high", 1000)
+	explicit.PreemptionPolicy = &preemptLowerPriority
+
+	clientSet1 := fake.NewSimpleClientset(newPriorityClass("high", 1000), newPriorityClass("system-cluster-critical", 2000000000))
+	clientSet2 := fake.NewSimpleClientset(explicit, newPriorityClass("system-node-critical", 2000001000))
+
+	isDiffer, err := ComparePriorityClasses(clientSet1, clientSet2, nil)
+	if err != nil {
+		t.Fatalf("cannot compare priority classes: %s", err.Error())
+	}
+	if isDiffer {
+		t.Error("Priority classes differing in defaulted fields and built-in classes only are reported as different")
+	}
+
+	clientSet2 = fake.NewSimpleClientset(newPriorityClass("high", 100))
+
+	isDiffer, err = ComparePriorityClasses(clientSet1, clientSet2, nil)
+	if err != nil {
+		t.Fatalf("cannot compare priority classes: %s", err.Error())
+	}
+	if !isDiffer {
+		t.Error("Priority classes with different values are not reported")
+	}
+}
+
+func TestCompareSpecInPriorityClasses(t *testing.T) {
+	never := v12.PreemptNever
+
+	priorityClass1 := v1.PriorityClass{Value: 1000}
+	priorityClass2 := v1.PriorityClass{Value: 1000, GlobalDefault: true}
+	if err := compareSpecInPriorityClasses(priorityClass1, priorityClass2); !errors.Is(err, ErrorGlobalDefaultDifferent) {
+		t.Error("Error expected: 'the global default in the priority classes is different'. But it was returned: ", err)
+	}
+
+	priorityClass2 = v1.PriorityClass{Value: 1000, PreemptionPolicy: &never}
+	if err := compareSpecInPriorityClasses(priorityClass1, priorityClass2); !errors.Is(err, ErrorPreemptionPolicyDifferent) {
+		t.Error("Error expected: 'the preemption policy in the priority classes is different'. But it was returned: ", err)
+	}
+}
+
+func TestCompareSpecInRuntimeClasses(t *testing.T) {
+	var list1, list2 runtimeClassList
+
+	// node.k8s.io/v1 and node.k8s.io/v1beta1 objects are decoded into the same structure
+	err := json.Unmarshal([]byte(`{"items": [{"metadata": {"name": "gvisor"}, "handler": "runsc",
+		"overhead": {"podFixed": {"cpu": "250m", "memory": "64Mi"}},
+		"scheduling": {"nodeSelector": {"runtime": "gvisor"}, "tolerations": [{"key": "sandbox", "operator": "Equal", "value": "true", "effect": "NoSchedule"}]}}]}`), &list1)
+	if err != nil {
+		t.Fatalf("cannot decode runtime classes: %s", err.Error())
+	}
+
+	err = json.Unmarshal([]byte(`{"items": [{"metadata": {"name": "gvisor"}, "handler": "runsc",
+		"overhead": {"podFixed": {"memory": "65536Ki", "cpu": "0.25"}},
+		"scheduling": {"nodeSelector": {"runtime": "gvisor"}, "tolerations": [{"key": "sandbox", "value": "true", "effect": "NoSchedule"}]}}]}`), &list2)
+	if err != nil {
+		t.Fatalf("cannot decode runtime classes: %s", err.Error())
+	}
+
+	if err := compareSpecInRuntimeClasses(list1.Items[0], list2.Items[0]); err != nil {
+		t.Error("Equal runtime classes are reported as different: ", err)
+	}
+
+	runtimeClass2 := list2.Items[0]
+	runtimeClass2.Handler = "kata"
+	if err := compareSpecInRuntimeClasses(list1.Items[0], runtimeClass2); !errors.Is(err, ErrorRuntimeHandlerDifferent) {
+		t.Error("Error expected: 'the handler in the runtime classes is different'. But it was returned: ", err)
+	}
+
+	runtimeClass2 = list2.Items[0]
+	runtimeClass2.Overhead = nil
+	if err := compareSpecInRuntimeClasses(list1.Items[0], runtimeClass2); !errors.Is(err, ErrorRuntimeOverheadDifferent) {
+		t.Error("Error expected: 'the pod overhead in the runtime classes is different'. But it was returned: ", err)
+	}
+
+	runtimeClass2 = list2.Items[0]
+	runtimeClass2.Scheduling = &v1beta1.Scheduling{NodeSelector: map[string]string{"runtime": "gvisor"}}
+	if err := compareSpecInRuntimeClasses(list1.Items[0], runtimeClass2); !errors.Is(err, ErrorRuntimeSchedulingDifferent) {
+		t.Error("Error expected: 'the scheduling in the runtime classes is different'. But it was returned: ", err)
+	}
+}
@@ -0,0 +1,18 @@
+package storage
+
+import "errors"
+
+var (
+	ErrorPVCSizeDifferent         = errors.New("the requested size in the persistent volume claims is different")
+	ErrorPVCAccessModesDifferent  = errors.New("the access modes in the persistent volume claims are different")
+	ErrorPVCStorageClassDifferent = errors.New("the storage class in the persistent volume claims is different")
+	ErrorPVCVolumeModeDifferent   = errors.New("the volume mode in the persistent volume claims is different")
+	ErrorPVCPhaseDifferent        = errors.New("the status in the persistent volume claims is different")
+
+	ErrorStorageClassProvisionerDifferent       = errors.New("the provisioner in the storage classes is different")
+	ErrorStorageClassParametersDifferent        = errors.New("the parameters in the storage classes are different")
+	ErrorStorageClassReclaimPolicyDifferent     = errors.New("the reclaim policy in the storage classes is different")
+	ErrorStorageClassVolumeBindingModeDifferent = errors.New("the volume binding mode in the storage classes is different")
+	ErrorStorageClassExpansionDifferent         = errors.New("the volume expansion in the storage classes is different")
+	ErrorStorageClassMountOptionsDifferent      = errors.New("the mount options in the storage classes are different")
+)
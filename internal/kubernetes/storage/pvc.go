@@ -0,0 +1,204 @@
+package storage
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	v12 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+	"k8s-cluster-comparator/internal/kubernetes/kv_maps"
+	"k8s-cluster-comparator/internal/kubernetes/skipper"
+	"k8s-cluster-comparator/internal/kubernetes/types"
+)
+
+// ComparePersistentVolumeClaims compares list of persistent volume claims objects in two given k8s-clusters
+func ComparePersistentVolumeClaims(clientSet1, clientSet2 kubernetes.Interface, namespace string, skipEntityList skipper.SkipEntitiesList) (bool, error) {
+	var (
+		isClustersDiffer bool
+	)
+
+	pvcs1, err := clientSet1.CoreV1().PersistentVolumeClaims(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain persistent volume claims list from 1st cluster: %w", err)
+	}
+
+	pvcs2, err := clientSet2.CoreV1().PersistentVolumeClaims(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain persistent volume claims list from 2nd cluster: %w", err)
+	}
+
+	mapPVCs1, mapPVCs2 := preparePVCMaps(pvcs1, pvcs2, skipEntityList.GetByKind("persistentvolumeclaims"))
+
+	isClustersDiffer = setInformationAboutPVCs(mapPVCs1, mapPVCs2, pvcs1, pvcs2)
+
+	return isClustersDiffer, nil
+}
+
+// preparePVCMaps add value persistent volume claims in map
+func preparePVCMaps(pvcs1, pvcs2 *v12.PersistentVolumeClaimList, skipEntities skipper.SkipComponentNames) (map[string]types.IsAlreadyComparedFlag, map[string]types.IsAlreadyComparedFlag) { //nolint:gocritic,unused
+	mapPVCs1 := make(map[string]types.IsAlreadyComparedFlag)
+	mapPVCs2 := make(map[string]types.IsAlreadyComparedFlag)
+	var indexCheck types.IsAlreadyComparedFlag
+
+	for index, value := range pvcs1.Items {
+		if skipEntities.IsSkippedEntity(value.Name) {
+			log.Debugf("persistent volume claim %s is skipped from comparison due to its name", value.Name)
+			continue
+		}
+		indexCheck.Index = index
+		mapPVCs1[value.Name] = indexCheck
+	}
+	for index, value := range pvcs2.Items {
+		if skipEntities.IsSkippedEntity(value.Name) {
+			log.Debugf("persistent volume claim %s is skipped from comparison due to its name", value.Name)
+			continue
+		}
+		indexCheck.Index = index
+		mapPVCs2[value.Name] = indexCheck
+	}
+
+	return mapPVCs1, mapPVCs2
+}
+
+// setInformationAboutPVCs set information about persistent volume claims
+func setInformationAboutPVCs(map1, map2 map[string]types.IsAlreadyComparedFlag, pvcs1, pvcs2 *v12.PersistentVolumeClaimList) bool {
+	var (
+		flag bool
+	)
+
+	if len(map1) != len(map2) {
+		log.Infof("persistent volume claims counts are different")
+		flag = true
+	}
+
+	wg := &sync.WaitGroup{}
+	channel := make(chan bool, len(map1))
+
+	for name, index1 := range map1 {
+		if index2, ok := map2[name]; ok {
+			wg.Add(1)
+
+			index1.Check = true
+			map1[name] = index1
+			index2.Check = true
+			map2[name] = index2
+
+			go comparePVCSpecInternals(wg, channel, name, &pvcs1.Items[index1.Index], &pvcs2.Items[index2.Index])
+		} else {
+			log.Infof("persistent volume claim '%s' does not exist in 2nd cluster", name)
+			flag = true
+			channel <- flag
+		}
+	}
+
+	wg.Wait()
+
+	close(channel)
+
+	for ch := range channel {
+		if ch {
+			flag = true
+		}
+	}
+	for name, index := range map2 {
+		if !index.Check {
+			log.Infof("persistent volume claim '%s' does not exist in 1st cluster", name)
+			flag = true
+		}
+	}
+
+	return flag
+}
+
+func comparePVCSpecInternals(wg *sync.WaitGroup, channel chan bool, name string, pvc1, pvc2 *v12.PersistentVolumeClaim) {
+	var (
+		flag bool
+	)
+	defer func() {
+		wg.Done()
+	}()
+
+	log.Debugf("----- Start checking persistent volume claim: '%s' -----", name)
+
+	if !kv_maps.AreKVMapsEqual(pvc1.ObjectMeta.Labels, pvc2.ObjectMeta.Labels, common.SkippedKubeLabels) {
+		log.Infof("metadata of persistent volume claim '%s' differs: different labels", pvc1.Name)
+		channel <- true
+		return
+	}
+
+	err := ComparePVCSpecs(*pvc1, *pvc2)
+	if err != nil {
+		log.Infof("PersistentVolumeClaim %s: %s", name, err.Error())
+		flag = true
+	}
+
+	log.Debugf("----- End checking persistent volume claim: '%s' -----", name)
+	channel <- flag
+}
+
+// ComparePVCSpecs compares requested size, access modes, storage class, volume mode and status of persistent volume claims
+func ComparePVCSpecs(pvc1, pvc2 v12.PersistentVolumeClaim) error {
+	size1, size2 := pvc1.Spec.Resources.Requests[v12.ResourceStorage], pvc2.Spec.Resources.Requests[v12.ResourceStorage]
+	if size1.Cmp(size2) != 0 {
+		return fmt.Errorf("%w. First claim: '%s'. Second claim: '%s'", ErrorPVCSizeDifferent, size1.String(), size2.String())
+	}
+
+	accessModes1, accessModes2 := formatAccessModes(pvc1.Spec.AccessModes), formatAccessModes(pvc2.Spec.AccessModes)
+	if accessModes1 != accessModes2 {
+		return fmt.Errorf("%w. First claim: '%s'. Second claim: '%s'", ErrorPVCAccessModesDifferent, accessModes1, accessModes2)
+	}
+
+	storageClass1, storageClass2 := getPVCStorageClass(pvc1), getPVCStorageClass(pvc2)
+	if storageClass1 != storageClass2 {
+		return fmt.Errorf("%w. First claim: '%s'. Second claim: '%s'", ErrorPVCStorageClassDifferent, storageClass1, storageClass2)
+	}
+
+	volumeMode1, volumeMode2 := getPVCVolumeMode(pvc1), getPVCVolumeMode(pvc2)
+	if volumeMode1 != volumeMode2 {
+		return fmt.Errorf("%w. First claim: '%s'. Second claim: '%s'", ErrorPVCVolumeModeDifferent, volumeMode1, volumeMode2)
+	}
+
+	if pvc1.Status.Phase != pvc2.Status.Phase {
+		return fmt.Errorf("%w. First claim: '%s'. Second claim: '%s'", ErrorPVCPhaseDifferent, pvc1.Status.Phase, pvc2.Status.Phase)
+	}
+
+	return nil
+}
+
+// formatAccessModes returns access modes in a form independent of their order
+func formatAccessModes(accessModes []v12.PersistentVolumeAccessMode) string {
+	modes := make([]string, 0, len(accessModes))
+	for _, mode := range accessModes {
+		modes = append(modes, string(mode))
+	}
+	sort.Strings(modes)
+
+	return strings.Join(modes, ", ")
+}
+
+// getPVCStorageClass returns the storage class of the claim taking into account the deprecated annotation
+func getPVCStorageClass(pvc v12.PersistentVolumeClaim) string {
+	if pvc.Spec.StorageClassName != nil {
+		return *pvc.Spec.StorageClassName
+	}
+
+	if storageClass, ok := pvc.Annotations[v12.BetaStorageClassAnnotation]; ok {
+		return storageClass
+	}
+
+	return "<default>"
+}
+
+// getPVCVolumeMode returns the volume mode of the claim, Filesystem is assumed if it is not set
+func getPVCVolumeMode(pvc v12.PersistentVolumeClaim) v12.PersistentVolumeMode {
+	if pvc.Spec.VolumeMode == nil {
+		return v12.PersistentVolumeFilesystem
+	}
+
+	return *pvc.Spec.VolumeMode
+}
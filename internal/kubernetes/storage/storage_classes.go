@@ -0,0 +1,213 @@
+package storage
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	v1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s-cluster-comparator/internal/kubernetes/common"
+	"k8s-cluster-comparator/internal/kubernetes/kv_maps"
+	"k8s-cluster-comparator/internal/kubernetes/skipper"
+	"k8s-cluster-comparator/internal/kubernetes/types"
+)
+
+const (
+	defaultStorageClassAnnotation     = "storageclass.kubernetes.io/is-default-class"
+	betaDefaultStorageClassAnnotation = "storageclass.beta.kubernetes.io/is-default-class"
+)
+
+// CompareStorageClasses compares list of cluster-scoped storage classes objects in two given k8s-clusters
+func CompareStorageClasses(clientSet1, clientSet2 kubernetes.Interface, skipEntityList skipper.SkipEntitiesList) (bool, error) {
+	var (
+		isClustersDiffer bool
+	)
+
+	storageClasses1, err := clientSet1.StorageV1().StorageClasses().List(metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain storage classes list from 1st cluster: %w", err)
+	}
+
+	storageClasses2, err := clientSet2.StorageV1().StorageClasses().List(metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("cannot obtain storage classes list from 2nd cluster: %w", err)
+	}
+
+	mapStorageClasses1, mapStorageClasses2 := prepareStorageClassMaps(storageClasses1, storageClasses2, skipEntityList.GetByKind("storageclasses"))
+
+	isClustersDiffer = setInformationAboutStorageClasses(mapStorageClasses1, mapStorageClasses2, storageClasses1, storageClasses2)
+
+	return isClustersDiffer, nil
+}
+
+// prepareStorageClassMaps add value storage classes in map
+func prepareStorageClassMaps(storageClasses1, storageClasses2 *v1.StorageClassList, skipEntities skipper.SkipComponentNames) (map[string]types.IsAlreadyComparedFlag, map[string]types.IsAlreadyComparedFlag) { //nolint:gocritic,unused
+	mapStorageClasses1 := make(map[string]types.IsAlreadyComparedFlag)
+	mapStorageClasses2 := make(map[string]types.IsAlreadyComparedFlag)
+	var indexCheck types.IsAlreadyComparedFlag
+
+	for index, value := range storageClasses1.Items {
+		if skipEntities.IsSkippedEntity(value.Name) {
+			log.Debugf("storage class %s is skipped from comparison due to its name", value.Name)
+			continue
+		}
+		indexCheck.Index = index
+		mapStorageClasses1[value.Name] = indexCheck
+	}
+	for index, value := range storageClasses2.Items {
+		if skipEntities.IsSkippedEntity(value.Name) {
+			log.Debugf("storage class %s is skipped from comparison due to its name", value.Name)
+			continue
+		}
+		indexCheck.Index = index
+		mapStorageClasses2[value.Name] = indexCheck
+	}
+
+	return mapStorageClasses1, mapStorageClasses2
+}
+
+// setInformationAboutStorageClasses set information about storage classes
+func setInformationAboutStorageClasses(map1, map2 map[string]types.IsAlreadyComparedFlag, storageClasses1, storageClasses2 *v1.StorageClassList) bool {
+	var (
+		flag bool
+	)
+
+	if len(map1) != len(map2) {
+		log.Infof("storage classes counts are different")
+		flag = true
+	}
+
+	wg := &sync.WaitGroup{}
+	channel := make(chan bool, len(map1))
+
+	for name, index1 := range map1 {
+		if index2, ok := map2[name]; ok {
+			wg.Add(1)
+
+			index1.Check = true
+			map1[name] = index1
+			index2.Check = true
+			map2[name] = index2
+
+			go compareStorageClassSpecInternals(wg, channel, name, &storageClasses1.Items[index1.Index], &storageClasses2.Items[index2.Index])
+		} else {
+			log.Infof("storage class '%s' does not exist in 2nd cluster", name)
+			flag = true
+			channel <- flag
+		}
+	}
+
+	wg.Wait()
+
+	close(channel)
+
+	for ch := range channel {
+		if ch {
+			flag = true
+		}
+	}
+	for name, index := range map2 {
+		if !index.Check {
+			log.Infof("storage class '%s' does not exist in 1st cluster", name)
+			flag = true
+		}
+	}
+
+	return flag
+}
+
+func compareStorageClassSpecInternals(wg *sync.WaitGroup, channel chan bool, name string, storageClass1, storageClass2 *v1.StorageClass) {
+	var (
+		flag bool
+	)
+	defer func() {
+		wg.Done()
+	}()
+
+	log.Debugf("----- Start checking storage class: '%s' -----", name)
+
+	if !kv_maps.AreKVMapsEqual(storageClass1.ObjectMeta.Labels, storageClass2.ObjectMeta.Labels, common.SkippedKubeLabels) {
+		log.Infof("metadata of storage class '%s' differs: different labels", storageClass1.Name)
+		channel <- true
+		return
+	}
+
+	if isDefaultStorageClass(*storageClass1) != isDefaultStorageClass(*storageClass2) {
+		log.Infof("storage class '%s' is the default one in one cluster only", storageClass1.Name)
+		channel <- true
+		return
+	}
+
+	err := compareSpecInStorageClasses(*storageClass1, *storageClass2)
+	if err != nil {
+		log.Infof("StorageClass %s: %s", name, err.Error())
+		flag = true
+	}
+
+	log.Debugf("----- End checking storage class: '%s' -----", name)
+	channel <- flag
+}
+
+// compareSpecInStorageClasses compares provisioner, parameters, reclaim policy, volume binding mode and expansion of storage classes
+func compareSpecInStorageClasses(storageClass1, storageClass2 v1.StorageClass) error {
+	if storageClass1.Provisioner != storageClass2.Provisioner {
+		return fmt.Errorf("%w. First class: '%s'. Second class: '%s'", ErrorStorageClassProvisionerDifferent, storageClass1.Provisioner, storageClass2.Provisioner)
+	}
+
+	if !kv_maps.AreKVMapsEqual(storageClass1.Parameters, storageClass2.Parameters, nil) {
+		return fmt.Errorf("%w. First class: '%v'. Second class: '%v'", ErrorStorageClassParametersDifferent, storageClass1.Parameters, storageClass2.Parameters)
+	}
+
+	reclaimPolicy1, reclaimPolicy2 := getReclaimPolicy(storageClass1), getReclaimPolicy(storageClass2)
+	if reclaimPolicy1 != reclaimPolicy2 {
+		return fmt.Errorf("%w. First class: '%s'. Second class: '%s'", ErrorStorageClassReclaimPolicyDifferent, reclaimPolicy1, reclaimPolicy2)
+	}
+
+	bindingMode1, bindingMode2 := getVolumeBindingMode(storageClass1), getVolumeBindingMode(storageClass2)
+	if bindingMode1 != bindingMode2 {
+		return fmt.Errorf("%w. First class: '%s'. Second class: '%s'", ErrorStorageClassVolumeBindingModeDifferent, bindingMode1, bindingMode2)
+	}
+
+	expansion1, expansion2 := isVolumeExpansionAllowed(storageClass1), isVolumeExpansionAllowed(storageClass2)
+	if expansion1 != expansion2 {
+		return fmt.Errorf("%w. First class: '%t'. Second class: '%t'", ErrorStorageClassExpansionDifferent, expansion1, expansion2)
+	}
+
+	mountOptions1, mountOptions2 := strings.Join(storageClass1.MountOptions, ", "), strings.Join(storageClass2.MountOptions, ", ")
+	if mountOptions1 != mountOptions2 {
+		return fmt.Errorf("%w. First class: '%s'. Second class: '%s'", ErrorStorageClassMountOptionsDifferent, mountOptions1, mountOptions2)
+	}
+
+	return nil
+}
+
+// isDefaultStorageClass checks whether the storage class is marked as the default one
+func isDefaultStorageClass(storageClass v1.StorageClass) bool {
+	return storageClass.Annotations[defaultStorageClassAnnotation] == "true" || storageClass.Annotations[betaDefaultStorageClassAnnotation] == "true"
+}
+
+// getReclaimPolicy returns the reclaim policy of the storage class, Delete is assumed if it is not set
+func getReclaimPolicy(storageClass v1.StorageClass) string {
+	if storageClass.ReclaimPolicy == nil {
+		return "Delete"
+	}
+
+	return string(*storageClass.ReclaimPolicy)
+}
+
+// getVolumeBindingMode returns the volume binding mode of the storage class, Immediate is assumed if it is not set
+func getVolumeBindingMode(storageClass v1.StorageClass) string {
+	if storageClass.VolumeBindingMode == nil {
+		return string(v1.VolumeBindingImmediate)
+	}
+
+	return string(*storageClass.VolumeBindingMode)
+}
+
+// isVolumeExpansionAllowed checks whether volumes of the storage class may be expanded
+func isVolumeExpansionAllowed(storageClass v1.StorageClass) bool {
+	return storageClass.AllowVolumeExpansion != nil && *storageClass.AllowVolumeExpansion
+}
@@ -0,0 +1,124 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v12 "k8s.io/api/core/v1"
+	v1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s-cluster-comparator/internal/kubernetes/skipper"
+	"k8s-cluster-comparator/internal/kubernetes/types"
+	"k8s-cluster-comparator/internal/logging"
+)
+
+func newPVC(name, size string, accessModes ...v12.PersistentVolumeAccessMode) v12.PersistentVolumeClaim {
+	storageClass := "standard"
+
+	return v12.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v12.PersistentVolumeClaimSpec{
+			AccessModes:      accessModes,
+			StorageClassName: &storageClass,
+			Resources: v12.ResourceRequirements{
+				Requests: v12.ResourceList{v12.ResourceStorage: resource.MustParse(size)},
+			},
+		},
+		Status: v12.PersistentVolumeClaimStatus{Phase: v12.ClaimBound},
+	}
+}
+
+func TestCompareSpecInPVCs(t *testing.T) {
+	pvc1 := newPVC("data", "1Gi", v12.ReadWriteOnce, v12.ReadOnlyMany)
+	pvc2 := newPVC("data", "1024Mi", v12.ReadOnlyMany, v12.ReadWriteOnce)
+
+	if err := ComparePVCSpecs(pvc1, pvc2); err != nil {
+		t.Error("Equal persistent volume claims are reported as different: ", err)
+	}
+
+	pvc2 = newPVC("data", "2Gi", v12.ReadWriteOnce, v12.ReadOnlyMany)
+	if err := ComparePVCSpecs(pvc1, pvc2); !errors.Is(err, ErrorPVCSizeDifferent) {
+		t.Error("Error expected: 'the requested size in the persistent volume claims is different'. But it was returned: ", err)
+	}
+
+	pvc2 = newPVC("data", "1Gi", v12.ReadWriteMany)
+	if err := ComparePVCSpecs(pvc1, pvc2); !errors.Is(err, ErrorPVCAccessModesDifferent) {
+		t.Error("Error expected: 'the access modes in the persistent volume claims are different'. But it was returned: ", err)
+	}
+
+	pvc2 = newPVC("data", "1Gi", v12.ReadWriteOnce, v12.ReadOnlyMany)
+	pvc2.Spec.StorageClassName = nil
+	if err := ComparePVCSpecs(pvc1, pvc2); !errors.Is(err, ErrorPVCStorageClassDifferent) {
+		t.Error("Error expected: 'the storage class in the persistent volume claims is different'. But it was returned: ", err)
+	}
+
+	pvc2 = newPVC("data", "1Gi", v12.ReadWriteOnce, v12.ReadOnlyMany)
+	pvc2.Status.Phase = v12.ClaimPending
+	if err := ComparePVCSpecs(pvc1, pvc2); !errors.Is(err, ErrorPVCPhaseDifferent) {
+		t.Error("Error expected: 'the status in the persistent volume claims is different'. But it was returned: ", err)
+	}
+}
+
+func TestCompareStorageClasses(t *testing.T) {
+	if err := logging.Configure(false); err != nil {
+		t.Fatalf("cannot configure logging: %s", err.Error())
+	}
+	if err := Init(context.Background()); err != nil {
+		t.Fatalf("cannot init storage package: %s", err.Error())
+	}
+
+	var (
+		retain    = v12.PersistentVolumeReclaimRetain
+		immediate = v1.VolumeBindingImmediate
+	)
+
+	newStorageClass := func(name, diskType string) *v1.StorageClass {
+		return &v1.StorageClass{
+			ObjectMeta:  metav1.ObjectMeta{Name: name},
+			Provisioner: "kubernetes.io/gce-pd",
+			Parameters:  map[string]string{"type": diskType},
+		}
+	}
+
+	explicit := newStorageClass("standard", "pd-standard")
+	explicit.VolumeBindingMode = &immediate
+
+	clientSet1 := fake.NewSimpleClientset(newStorageClass("standard", "pd-standard"), newStorageClass("fast", "pd-ssd"))
+	clientSet2 := fake.NewSimpleClientset(explicit, newStorageClass("fast", "pd-ssd"))
+
+	isDiffer, err := CompareStorageClasses(clientSet1, clientSet2, nil)
+	if err != nil {
+		t.Fatalf("cannot compare storage classes: %s", err.Error())
+	}
+	if isDiffer {
+		t.Error("Storage classes differing in defaulted fields only are reported as different")
+	}
+
+	retained := newStorageClass("fast", "pd-ssd")
+	retained.ReclaimPolicy = &retain
+	clientSet2 = fake.NewSimpleClientset(explicit, retained)
+
+	isDiffer, err = CompareStorageClasses(clientSet1, clientSet2, nil)
+	if err != nil {
+		t.Fatalf("cannot compare storage classes: %s", err.Error())
+	}
+	if !isDiffer {
+		t.Error("Storage classes with different reclaim policies are not reported")
+	}
+
+	skipList := skipper.SkipEntitiesList{
+		types.ObjectKind("storageclasses"): skipper.SkipComponentNames{types.ObjectName("fast"): {}},
+	}
+
+	isDiffer, err = CompareStorageClasses(clientSet1, clientSet2, skipList)
+	if err != nil {
+		t.Fatalf("cannot compare storage classes: %s", err.Error())
+	}
+	if isDiffer {
+		t.Error("Skipped storage class is compared")
+	}
+}